@@ -0,0 +1,62 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional
+
+// A Presence reports whether a value is held. A pointer to an [Optional] of any
+// type is a Presence.
+type Presence interface {
+	HasValue() bool
+}
+
+var _ Presence = (*Optional[any])(nil)
+
+// A Builder constructs a value of type T only if all of its required
+// optionals hold values. The zero value of a Builder has no requirements and
+// is ready to use.
+type Builder[T any] struct {
+	missing bool
+}
+
+// NewBuilder produces a [Builder] with no requirements.
+func NewBuilder[T any]() *Builder[T] {
+	return &Builder[T]{}
+}
+
+// Require adds opts to the set of optionals that must hold values, and returns
+// the receiver for chaining.
+func (b *Builder[T]) Require(opts ...Presence) *Builder[T] {
+	for _, opt := range opts {
+		if !opt.HasValue() {
+			b.missing = true
+		}
+	}
+	return b
+}
+
+// Build returns an [Optional] holding the result of assemble if all required
+// optionals hold values, or an [Optional] that holds no value otherwise. The
+// given function is only evaluated if all required optionals hold values.
+func (b *Builder[T]) Build(assemble func() T) Optional[T] {
+	if b.missing {
+		return None[T]()
+	}
+	return Some(assemble())
+}
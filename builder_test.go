@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+)
+
+type testUser struct {
+	Name  string
+	Age   int
+	Admin bool
+}
+
+func TestBuilder(t *testing.T) {
+	var (
+		name  = optional.Some("foo")
+		age   = optional.Some(0)
+		admin = optional.None[bool]()
+		calls int
+	)
+
+	assemble := func() testUser {
+		calls++
+		return testUser{
+			Name:  name.Value(),
+			Age:   age.Value(),
+			Admin: admin.ValueOr(false),
+		}
+	}
+
+	res := optional.NewBuilder[testUser]().
+		Require(&name).
+		Require(&age).
+		Build(assemble)
+	requireOptionalHasValue(t, testUser{Name: "foo"}, res)
+	require.Equal(t, 1, calls)
+
+	res = optional.NewBuilder[testUser]().
+		Require(&name, &age, &admin).
+		Build(assemble)
+	require.False(t, res.HasValue())
+	require.Equal(t, 1, calls)
+
+	var builder optional.Builder[testUser]
+	requireOptionalHasValue(t, testUser{Name: "foo"}, builder.Build(assemble))
+	require.Equal(t, 2, calls)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional
+
+// Send sends the held value on ch, or does nothing if no value is held. Normal
+// channel semantics apply when a value is held: Send blocks if ch is unbuffered
+// or full (and forever if ch is nil), and panics if ch is closed.
+func (o *Optional[T]) Send(ch chan<- T) {
+	if o.isset {
+		ch <- o.value
+	}
+}
+
+// Recv performs a non-blocking receive on ch and returns an [Optional] holding
+// the received value. If no value is ready, or if ch is closed and drained,
+// Recv returns an [Optional] that holds no value; the two cases are not
+// distinguished.
+func Recv[T any](ch <-chan T) Optional[T] {
+	select {
+	case value, ok := <-ch:
+		if !ok {
+			return None[T]()
+		}
+		return Some(value)
+	default:
+		return None[T]()
+	}
+}
+
+// RecvBlocking performs a blocking receive on ch and returns an [Optional]
+// holding the received value, or an [Optional] that holds no value if ch is
+// closed. Unlike a plain receive, a closed channel does not produce the zero
+// value of T.
+func RecvBlocking[T any](ch <-chan T) Optional[T] {
+	value, ok := <-ch
+	if !ok {
+		return None[T]()
+	}
+	return Some(value)
+}
+
+// ToChan returns a closed channel that yields the value held by o, if any.
+// Ranging over the channel therefore yields exactly one value if o holds a
+// value, and none otherwise.
+func ToChan[T any](o Optional[T]) <-chan T {
+	ch := make(chan T, 1)
+	if o.isset {
+		ch <- o.value
+	}
+	close(ch)
+	return ch
+}
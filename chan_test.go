@@ -0,0 +1,95 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+)
+
+func TestOptional_Send(t *testing.T) {
+	ch := make(chan int, 2)
+
+	opt := optional.Some(123)
+	opt.Send(ch)
+	require.Len(t, ch, 1)
+	require.Equal(t, 123, <-ch)
+
+	opt = optional.None[int]()
+	opt.Send(ch)
+	require.Len(t, ch, 0)
+
+	// A nil channel would block forever if a send were attempted.
+	var nilch chan int
+	opt.Send(nilch)
+}
+
+func TestRecv(t *testing.T) {
+	ch := make(chan int, 1)
+
+	opt := optional.Recv(ch)
+	require.False(t, opt.HasValue())
+
+	ch <- 123
+	requireOptionalHasValue(t, 123, optional.Recv(ch))
+
+	ch <- 0
+	requireOptionalHasValue(t, 0, optional.Recv(ch))
+
+	ch <- 234
+	close(ch)
+	requireOptionalHasValue(t, 234, optional.Recv(ch))
+
+	opt = optional.Recv(ch)
+	require.False(t, opt.HasValue())
+}
+
+func TestRecvBlocking(t *testing.T) {
+	ch := make(chan int)
+
+	go func() {
+		ch <- 123
+		ch <- 0
+		close(ch)
+	}()
+
+	requireOptionalHasValue(t, 123, optional.RecvBlocking(ch))
+	requireOptionalHasValue(t, 0, optional.RecvBlocking(ch))
+
+	opt := optional.RecvBlocking(ch)
+	require.False(t, opt.HasValue())
+}
+
+func TestToChan(t *testing.T) {
+	var have []int
+	for x := range optional.ToChan(optional.Some(123)) {
+		have = append(have, x)
+	}
+	require.Equal(t, []int{123}, have)
+
+	have = nil
+	for x := range optional.ToChan(optional.None[int]()) {
+		have = append(have, x)
+	}
+	require.Empty(t, have)
+}
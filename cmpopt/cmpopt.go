@@ -0,0 +1,74 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+// Package cmpopt provides [github.com/google/go-cmp/cmp] options for comparing
+// optional types.
+package cmpopt
+
+import (
+	"reflect"
+
+	"github.com/google/go-cmp/cmp"
+	"go.mway.dev/optional"
+	"go.mway.dev/optional/internal/optreflect"
+)
+
+// CmpOption returns a [cmp.Option] that allows [cmp.Equal] and [cmp.Diff] to
+// compare [optional.Optional] values of any type by their [Value]
+// representation: two optionals that hold no value are equal, and two
+// optionals that hold values are equal if their values are equal.
+func CmpOption() cmp.Option {
+	return cmp.FilterPath(
+		func(p cmp.Path) bool {
+			return optreflect.IsOptional(p.Last().Type())
+		},
+		cmp.Transformer("optional", toValue),
+	)
+}
+
+// toValue converts an [optional.Optional] of any type into its [Value]
+// representation.
+func toValue(opt any) Value[any] {
+	value, ok := optreflect.Get(reflect.ValueOf(opt))
+	return Value[any]{
+		Value:   value,
+		Present: ok,
+	}
+}
+
+// A Value is the (value, present) representation of an [optional.Optional]
+// used by [CmpOption] and [Transformer].
+type Value[T any] struct {
+	Value   T
+	Present bool
+}
+
+// Transformer returns a [cmp.Option] that transforms [optional.Optional]
+// values of type T into their [Value] representation for comparison. Unlike
+// [CmpOption], it applies only to optionals of type T.
+func Transformer[T any]() cmp.Option {
+	return cmp.Transformer("optional", func(o optional.Optional[T]) Value[T] {
+		value, ok := o.Get()
+		return Value[T]{
+			Value:   value,
+			Present: ok,
+		}
+	})
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package cmpopt_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+	"go.mway.dev/optional/cmpopt"
+)
+
+type testRecord struct {
+	Name  optional.Optional[string]
+	Count optional.Optional[int]
+	Tags  optional.Optional[[]string]
+}
+
+func TestCmpOption(t *testing.T) {
+	opt := cmpopt.CmpOption()
+
+	require.Empty(t, cmp.Diff(optional.None[int](), optional.None[int](), opt))
+	require.Empty(t, cmp.Diff(optional.Some(123), optional.Some(123), opt))
+	require.NotEmpty(t, cmp.Diff(optional.Some(123), optional.Some(234), opt))
+
+	diff := cmp.Diff(optional.None[int](), optional.Some(0), opt)
+	require.Contains(t, diff, "Present")
+	require.NotContains(t, diff, "isset")
+
+	a := testRecord{
+		Name: optional.Some("foo"),
+		Tags: optional.Some([]string{"a", "b"}),
+	}
+	b := testRecord{
+		Name: optional.Some("foo"),
+		Tags: optional.Some([]string{"a", "b"}),
+	}
+	require.Empty(t, cmp.Diff(a, b, opt))
+
+	b.Count = optional.Some(0)
+	diff = cmp.Diff(a, b, opt)
+	require.Contains(t, diff, "Present")
+	require.NotContains(t, diff, "isset")
+
+	require.Panics(t, func() {
+		cmp.Diff(optional.Some(123), optional.Some(123))
+	})
+}
+
+func TestTransformer(t *testing.T) {
+	opt := cmpopt.Transformer[int]()
+
+	require.Empty(t, cmp.Diff(optional.None[int](), optional.None[int](), opt))
+	require.Empty(t, cmp.Diff(optional.Some(123), optional.Some(123), opt))
+	require.NotEmpty(t, cmp.Diff(optional.Some(123), optional.Some(234), opt))
+
+	diff := cmp.Diff(optional.None[int](), optional.Some(0), opt)
+	require.Contains(t, diff, "Present")
+
+	opts := cmp.Options{
+		cmpopt.Transformer[string](),
+		cmpopt.Transformer[int](),
+		cmpopt.Transformer[[]string](),
+	}
+	a := testRecord{Name: optional.Some("foo")}
+	b := testRecord{Name: optional.Some("foo")}
+	require.Empty(t, cmp.Diff(a, b, opts))
+
+	b.Name = optional.None[string]()
+	require.NotEmpty(t, cmp.Diff(a, b, opts))
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional
+
+import (
+	"cmp"
+	"hash/maphash"
+	"reflect"
+)
+
+// noneHash is the hash of every [Optional] that holds no value. No [Optional]
+// holding a value hashes to noneHash.
+const noneHash uint64 = 0x9e3779b97f4a7c15
+
+var hashSeed = maphash.MakeSeed()
+
+// Cmp compares a and b, returning -1 if a is less than b, 0 if they are equal,
+// and +1 if a is greater than b. An [Optional] that holds no value orders
+// before any [Optional] that does, and two held values are ordered using
+// [cmp.Compare]. Cmp is suitable for use with [slices.SortFunc].
+func Cmp[T cmp.Ordered](a Optional[T], b Optional[T]) int {
+	switch {
+	case !a.isset && !b.isset:
+		return 0
+	case !a.isset:
+		return -1
+	case !b.isset:
+		return 1
+	default:
+		return cmp.Compare(a.value, b.value)
+	}
+}
+
+// Equals reports whether a and b are equal: either both hold no value, or both
+// hold values that are equal. Equality cannot be offered as a method on
+// [Optional], because methods cannot further constrain T (which is any) to be
+// comparable; use this function instead.
+func Equals[T comparable](a Optional[T], b Optional[T]) bool {
+	if a.isset != b.isset {
+		return false
+	}
+	return !a.isset || a.value == b.value
+}
+
+// Hash returns a hash of o. Optionals that are [Equals] hash equally, every
+// [None] hashes to the same fixed sentinel, and no [Some] (including one that
+// holds the zero value of T) hashes to that sentinel. Hashes are only stable
+// within a single process.
+func Hash[T comparable](o Optional[T]) uint64 {
+	if !o.isset {
+		return noneHash
+	}
+
+	h := maphash.Comparable(hashSeed, o.value)
+	if h == noneHash {
+		h++
+	}
+	return h
+}
+
+// DeepEqual reports whether a and b are deeply equal: either both hold no
+// value, or both hold values that are equal according to [reflect.DeepEqual].
+// Unlike [Equals], T need not be comparable.
+func DeepEqual[T any](a Optional[T], b Optional[T]) bool {
+	if a.isset != b.isset {
+		return false
+	}
+	return !a.isset || reflect.DeepEqual(a.value, b.value)
+}
+
+// A Comparable is a plain representation of an [Optional]. It is comparable,
+// and thus usable as a map key, whenever T is comparable. An [Optional] that
+// holds no value is represented as {Present: false} with the zero value of T.
+type Comparable[T any] struct {
+	Present bool
+	Value   T
+}
+
+// AsComparable returns the [Comparable] representation of the receiver.
+func (o *Optional[T]) AsComparable() Comparable[T] {
+	return Comparable[T]{
+		Present: o.isset,
+		Value:   o.value,
+	}
+}
+
+// A Change describes a difference between two optionals.
+type Change[T any] struct {
+	From Optional[T]
+	To   Optional[T]
+}
+
+// Diff returns an [Optional] holding a [Change] from one [Optional] to
+// another if they differ in presence or value, or an [Optional] that holds no
+// value if they are [Equals].
+func Diff[T comparable](from Optional[T], to Optional[T]) Optional[Change[T]] {
+	if Equals(from, to) {
+		return None[Change[T]]()
+	}
+	return Some(Change[T]{
+		From: from,
+		To:   to,
+	})
+}
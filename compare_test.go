@@ -0,0 +1,193 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+)
+
+func TestCmp(t *testing.T) {
+	require.Equal(t, 0, optional.Cmp(optional.None[int](), optional.None[int]()))
+	require.Equal(t, -1, optional.Cmp(optional.None[int](), optional.Some(0)))
+	require.Equal(t, 1, optional.Cmp(optional.Some(0), optional.None[int]()))
+	require.Equal(t, -1, optional.Cmp(optional.Some(1), optional.Some(2)))
+	require.Equal(t, 0, optional.Cmp(optional.Some(2), optional.Some(2)))
+	require.Equal(t, 1, optional.Cmp(optional.Some(3), optional.Some(2)))
+
+	opts := []optional.Optional[int]{
+		optional.Some(3),
+		optional.None[int](),
+		optional.Some(1),
+		optional.None[int](),
+		optional.Some(2),
+	}
+	slices.SortFunc(opts, optional.Cmp[int])
+
+	require.Equal(t, []optional.Optional[int]{
+		optional.None[int](),
+		optional.None[int](),
+		optional.Some(1),
+		optional.Some(2),
+		optional.Some(3),
+	}, opts)
+}
+
+func TestEquals(t *testing.T) {
+	cases := []struct {
+		name string
+		a    optional.Optional[int]
+		b    optional.Optional[int]
+		want bool
+	}{
+		{
+			name: "none none",
+			a:    optional.None[int](),
+			b:    optional.None[int](),
+			want: true,
+		},
+		{
+			name: "none some",
+			a:    optional.None[int](),
+			b:    optional.Some(0),
+			want: false,
+		},
+		{
+			name: "some none",
+			a:    optional.Some(0),
+			b:    optional.None[int](),
+			want: false,
+		},
+		{
+			name: "some some equal",
+			a:    optional.Some(123),
+			b:    optional.Some(123),
+			want: true,
+		},
+		{
+			name: "some some unequal",
+			a:    optional.Some(123),
+			b:    optional.Some(234),
+			want: false,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, optional.Equals(tt.a, tt.b))
+			require.Equal(t, tt.want, optional.Equals(tt.b, tt.a))
+		})
+	}
+}
+
+func TestHash(t *testing.T) {
+	none := optional.Hash(optional.None[int]())
+	require.Equal(t, none, optional.Hash(optional.None[int]()))
+	require.Equal(t, none, optional.Hash(optional.None[string]()))
+	require.NotEqual(t, none, optional.Hash(optional.Some(0)))
+	require.NotEqual(t, none, optional.Hash(optional.Some("")))
+
+	require.Equal(t, optional.Hash(optional.Some(123)), optional.Hash(optional.Some(123)))
+	require.NotEqual(t, optional.Hash(optional.Some(123)), optional.Hash(optional.Some(234)))
+	require.Equal(
+		t,
+		optional.Hash(optional.Some(t.Name())),
+		optional.Hash(optional.Some(t.Name())),
+	)
+}
+
+func TestDeepEqual(t *testing.T) {
+	require.True(t, optional.DeepEqual(optional.None[[]int](), optional.None[[]int]()))
+	require.False(t, optional.DeepEqual(optional.None[[]int](), optional.Some([]int{})))
+	require.False(t, optional.DeepEqual(optional.Some([]int{}), optional.None[[]int]()))
+	require.True(t, optional.DeepEqual(optional.Some([]int{1, 2}), optional.Some([]int{1, 2})))
+	require.False(t, optional.DeepEqual(optional.Some([]int{1, 2}), optional.Some([]int{2, 1})))
+
+	require.True(t, optional.DeepEqual(
+		optional.Some(map[string]int{"a": 1, "b": 2}),
+		optional.Some(map[string]int{"b": 2, "a": 1}),
+	))
+	require.False(t, optional.DeepEqual(
+		optional.Some(map[string]int{"a": 1}),
+		optional.Some(map[string]int{"a": 2}),
+	))
+	require.False(t, optional.DeepEqual(
+		optional.Some(map[string]int{}),
+		optional.None[map[string]int](),
+	))
+}
+
+func TestOptional_AsComparable(t *testing.T) {
+	var (
+		none = optional.None[int]()
+		zero = optional.Some(0)
+		some = optional.Some(123)
+	)
+
+	require.Equal(t, optional.Comparable[int]{}, none.AsComparable())
+	require.Equal(t, optional.Comparable[int]{Present: true}, zero.AsComparable())
+	require.Equal(
+		t,
+		optional.Comparable[int]{Present: true, Value: 123},
+		some.AsComparable(),
+	)
+
+	m := map[optional.Comparable[int]]string{
+		none.AsComparable(): "none",
+		zero.AsComparable(): "zero",
+	}
+	require.Len(t, m, 2)
+
+	m[some.AsComparable()] = "some"
+	require.Len(t, m, 3)
+	require.Equal(t, "none", m[none.AsComparable()])
+	require.Equal(t, "zero", m[zero.AsComparable()])
+	require.Equal(t, "some", m[some.AsComparable()])
+}
+
+func TestDiff(t *testing.T) {
+	res := optional.Diff(optional.None[int](), optional.None[int]())
+	require.False(t, res.HasValue())
+
+	res = optional.Diff(optional.Some(123), optional.Some(123))
+	require.False(t, res.HasValue())
+
+	res = optional.Diff(optional.Some(123), optional.Some(234))
+	requireOptionalHasValue(t, optional.Change[int]{
+		From: optional.Some(123),
+		To:   optional.Some(234),
+	}, res)
+
+	res = optional.Diff(optional.None[int](), optional.Some(0))
+	requireOptionalHasValue(t, optional.Change[int]{
+		From: optional.None[int](),
+		To:   optional.Some(0),
+	}, res)
+
+	res = optional.Diff(optional.Some(0), optional.None[int]())
+	requireOptionalHasValue(t, optional.Change[int]{
+		From: optional.Some(0),
+		To:   optional.None[int](),
+	}, res)
+}
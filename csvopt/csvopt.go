@@ -0,0 +1,40 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+// Package csvopt provides helpers for mapping CSV cells to optional values.
+package csvopt
+
+import (
+	"go.mway.dev/optional"
+)
+
+// ParseCell parses field using parse and returns an [optional.Optional]
+// holding the result. An empty field always produces an [optional.Optional]
+// that holds no value without calling parse, as does a field that parse fails
+// to parse.
+func ParseCell[T any](
+	field string,
+	parse func(string) (T, error),
+) optional.Optional[T] {
+	if field == "" {
+		return optional.None[T]()
+	}
+	return optional.FromResult(parse(field))
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package csvopt_test
+
+import (
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+	"go.mway.dev/optional/csvopt"
+)
+
+func TestParseCell(t *testing.T) {
+	var calls int
+	atoi := func(s string) (int, error) {
+		calls++
+		return strconv.Atoi(s)
+	}
+
+	opt := csvopt.ParseCell("", atoi)
+	require.False(t, opt.HasValue())
+	require.Equal(t, 0, calls)
+
+	opt = csvopt.ParseCell("123", atoi)
+	value, ok := opt.Get()
+	require.True(t, ok)
+	require.Equal(t, 123, value)
+
+	opt = csvopt.ParseCell("abc", atoi)
+	require.False(t, opt.HasValue())
+	require.Equal(t, 2, calls)
+
+	str := csvopt.ParseCell("", func(s string) (string, error) { return s, nil })
+	require.False(t, str.HasValue())
+}
+
+func TestParseCell_Records(t *testing.T) {
+	records, err := csv.NewReader(strings.NewReader("1,\n,2\nx,3\n")).ReadAll()
+	require.NoError(t, err)
+
+	var have [][]optional.Optional[int]
+	for _, record := range records {
+		row := make([]optional.Optional[int], len(record))
+		for i, field := range record {
+			row[i] = csvopt.ParseCell(field, strconv.Atoi)
+		}
+		have = append(have, row)
+	}
+
+	require.Equal(t, [][]optional.Optional[int]{
+		{optional.Some(1), optional.None[int]()},
+		{optional.None[int](), optional.Some(2)},
+		{optional.None[int](), optional.Some(3)},
+	}, have)
+}
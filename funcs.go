@@ -0,0 +1,277 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional
+
+// Combine produces an [Optional] holding the result of build if both a and b
+// hold values, or an [Optional] that holds no value otherwise. It is the
+// primary "both present, then construct" primitive; build is only called when
+// both values are present.
+func Combine[A any, B any, R any](
+	a Optional[A],
+	b Optional[B],
+	build func(A, B) R,
+) Optional[R] {
+	if !a.isset || !b.isset {
+		return None[R]()
+	}
+	return Some(build(a.value, b.value))
+}
+
+// MapErr maps the value held by o to a value of type Out using the fallible
+// function fn. If o holds no value, MapErr returns an [Optional] that holds no
+// value and a nil error without calling fn. If fn returns an error, MapErr
+// returns an [Optional] that holds no value and that error.
+func MapErr[In any, Out any](
+	o Optional[In],
+	fn func(In) (Out, error),
+) (Optional[Out], error) {
+	if !o.isset {
+		return None[Out](), nil
+	}
+
+	value, err := fn(o.value)
+	if err != nil {
+		return None[Out](), err
+	}
+	return Some(value), nil
+}
+
+// Match calls some with the value held by o, or none if o holds no value, and
+// returns the result. Exactly one of some or none is called.
+func Match[T any, Out any](
+	o Optional[T],
+	some func(T) Out,
+	none func() Out,
+) Out {
+	if o.isset {
+		return some(o.value)
+	}
+	return none()
+}
+
+// MapKeepNone maps the value held by o to a value of type Out using fn, which
+// reports whether it produced a value. The result holds no value if either o
+// holds no value (in which case fn is not called) or fn returns false.
+func MapKeepNone[In any, Out any](
+	o Optional[In],
+	fn func(In) (Out, bool),
+) Optional[Out] {
+	if !o.isset {
+		return None[Out]()
+	}
+
+	value, ok := fn(o.value)
+	if !ok {
+		return None[Out]()
+	}
+	return Some(value)
+}
+
+// Require3 returns the values held by a, b, and c and true if all three hold
+// values, or the zero values of A, B, and C and false otherwise.
+func Require3[A any, B any, C any](
+	a Optional[A],
+	b Optional[B],
+	c Optional[C],
+) (A, B, C, bool) {
+	if !a.isset || !b.isset || !c.isset {
+		var (
+			zeroA A
+			zeroB B
+			zeroC C
+		)
+		return zeroA, zeroB, zeroC, false
+	}
+	return a.value, b.value, c.value, true
+}
+
+// Try calls fn and returns an [Optional] holding its result, or an [Optional]
+// that holds no value if fn panics. The recovered panic value is discarded;
+// use [TryRecover] to observe it.
+func Try[T any](fn func() T) Optional[T] {
+	return TryRecover(fn, nil)
+}
+
+// TryRecover calls fn and returns an [Optional] holding its result, or an
+// [Optional] that holds no value if fn panics. If fn panics and onPanic is not
+// nil, onPanic is called with the recovered panic value.
+func TryRecover[T any](fn func() T, onPanic func(recovered any)) (opt Optional[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			opt = None[T]()
+			if onPanic != nil {
+				onPanic(r)
+			}
+		}
+	}()
+
+	return Some(fn())
+}
+
+// TryErr calls fn and returns an [Optional] holding its result if fn returns
+// a nil error, or an [Optional] that holds no value otherwise. On error, the
+// value returned by fn is discarded.
+func TryErr[T any](fn func() (T, error)) Optional[T] {
+	value, err := fn()
+	if err != nil {
+		return None[T]()
+	}
+	return Some(value)
+}
+
+// Pipe applies each of steps in order, passing the value held by the result of
+// the previous step (or o for the first step) to the next. Pipe returns an
+// [Optional] that holds no value as soon as o or any step holds no value; no
+// further steps are called.
+func Pipe[T any](o Optional[T], steps ...func(T) Optional[T]) Optional[T] {
+	for _, step := range steps {
+		if !o.isset {
+			break
+		}
+		o = step(o.value)
+	}
+	return o
+}
+
+// A Pair holds two values of possibly different types.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// ZipOuter produces an [Optional] holding a [Pair] of a and b if at least one
+// of them holds a value, or an [Optional] that holds no value if neither does.
+// It is the outer-join counterpart to [Combine].
+func ZipOuter[A any, B any](
+	a Optional[A],
+	b Optional[B],
+) Optional[Pair[Optional[A], Optional[B]]] {
+	if !a.isset && !b.isset {
+		return None[Pair[Optional[A], Optional[B]]]()
+	}
+	return Some(Pair[Optional[A], Optional[B]]{
+		First:  a,
+		Second: b,
+	})
+}
+
+// MapOrOpt returns an [Optional] holding the result of calling transform with
+// the value held by o, or fallback (which may itself hold no value) if o holds
+// no value. transform is only called if o holds a value.
+func MapOrOpt[In any, Out any](
+	o Optional[In],
+	fallback Optional[Out],
+	transform func(In) Out,
+) Optional[Out] {
+	if !o.isset {
+		return fallback
+	}
+	return Some(transform(o.value))
+}
+
+// OrErr returns the value held by o and the zero value of E if o holds a
+// value, or the zero value of T and err otherwise. Unlike functions returning
+// an error, E may be any type, such as a typed error enum.
+func OrErr[T any, E any](o Optional[T], err E) (T, E) {
+	if !o.isset {
+		var zero T
+		return zero, err
+	}
+
+	var zero E
+	return o.value, zero
+}
+
+// MapOrElseOpt returns an [Optional] holding the result of calling transform
+// with the value held by o, or the result of fallback (which may itself hold no
+// value) if o holds no value. Only one of transform or fallback is called.
+func MapOrElseOpt[In any, Out any](
+	o Optional[In],
+	fallback func() Optional[Out],
+	transform func(In) Out,
+) Optional[Out] {
+	if !o.isset {
+		return fallback()
+	}
+	return Some(transform(o.value))
+}
+
+// SwapIfDifferent sets o to hold value, returning the previous contents and
+// true, unless o already holds a value equal to value, in which case o is left
+// unchanged and SwapIfDifferent returns the current contents and false. It is
+// a free function rather than a method because it requires T to be
+// comparable.
+func SwapIfDifferent[T comparable](o *Optional[T], value T) (previous Optional[T], changed bool) {
+	if o.isset && o.value == value {
+		return *o, false
+	}
+	return o.SwapOpt(Some(value)), true
+}
+
+// AndThenOk returns the result of calling fn with the value held by o, or the
+// zero value of Out and err if o holds no value. fn is only called if o holds
+// a value.
+func AndThenOk[In any, Out any](
+	o Optional[In],
+	err error,
+	fn func(In) (Out, error),
+) (Out, error) {
+	if !o.isset {
+		var zero Out
+		return zero, err
+	}
+	return fn(o.value)
+}
+
+// Normalize returns an [Optional] that holds no value if o holds a nil
+// pointer, and returns o unchanged otherwise.
+func Normalize[T any](o Optional[*T]) Optional[*T] {
+	if o.isset && o.value == nil {
+		return None[*T]()
+	}
+	return o
+}
+
+// MapOrZero returns the result of calling transform with the value held by o,
+// or the zero value of Out if o holds no value. transform is only called if o
+// holds a value.
+func MapOrZero[In any, Out any](o Optional[In], transform func(In) Out) Out {
+	if !o.isset {
+		var zero Out
+		return zero
+	}
+	return transform(o.value)
+}
+
+// ZipOrFunc returns a [Pair] of the values held by a and b, using the result of
+// fillA or fillB in place of a side that holds no value. Each fill function is
+// only called if its side holds no value.
+func ZipOrFunc[A any, B any](
+	a Optional[A],
+	b Optional[B],
+	fillA func() A,
+	fillB func() B,
+) Pair[A, B] {
+	return Pair[A, B]{
+		First:  a.ValueOrFunc(fillA),
+		Second: b.ValueOrFunc(fillB),
+	}
+}
@@ -0,0 +1,474 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+)
+
+func TestCombine(t *testing.T) {
+	var calls int
+	build := func(a int, b string) string {
+		calls++
+		return strconv.Itoa(a) + b
+	}
+
+	res := optional.Combine(optional.None[int](), optional.None[string](), build)
+	require.False(t, res.HasValue())
+
+	res = optional.Combine(optional.Some(1), optional.None[string](), build)
+	require.False(t, res.HasValue())
+
+	res = optional.Combine(optional.None[int](), optional.Some("a"), build)
+	require.False(t, res.HasValue())
+	require.Equal(t, 0, calls)
+
+	res = optional.Combine(optional.Some(1), optional.Some("a"), build)
+	requireOptionalHasValue(t, "1a", res)
+	require.Equal(t, 1, calls)
+}
+
+func TestMapErr(t *testing.T) {
+	var calls int
+	atoi := func(s string) (int, error) {
+		calls++
+		return strconv.Atoi(s)
+	}
+
+	res, err := optional.MapErr(optional.None[string](), atoi)
+	require.NoError(t, err)
+	require.False(t, res.HasValue())
+	require.Equal(t, 0, calls)
+
+	res, err = optional.MapErr(optional.Some("123"), atoi)
+	require.NoError(t, err)
+	requireOptionalHasValue(t, 123, res)
+
+	res, err = optional.MapErr(optional.Some("abc"), atoi)
+	require.ErrorIs(t, err, strconv.ErrSyntax)
+	require.False(t, res.HasValue())
+	require.Equal(t, 2, calls)
+}
+
+func TestMatch(t *testing.T) {
+	var someCalls, noneCalls int
+	some := func(x int) string {
+		someCalls++
+		return strconv.Itoa(x)
+	}
+	none := func() string {
+		noneCalls++
+		return "none"
+	}
+
+	require.Equal(t, "123", optional.Match(optional.Some(123), some, none))
+	require.Equal(t, 1, someCalls)
+	require.Equal(t, 0, noneCalls)
+
+	require.Equal(t, "none", optional.Match(optional.None[int](), some, none))
+	require.Equal(t, 1, someCalls)
+	require.Equal(t, 1, noneCalls)
+}
+
+func TestMapKeepNone(t *testing.T) {
+	var (
+		calls  int
+		lookup = map[int]string{1: "one", 0: ""}
+		fn     = func(x int) (string, bool) {
+			calls++
+			value, ok := lookup[x]
+			return value, ok
+		}
+	)
+
+	res := optional.MapKeepNone(optional.None[int](), fn)
+	require.False(t, res.HasValue())
+	require.Equal(t, 0, calls)
+
+	res = optional.MapKeepNone(optional.Some(2), fn)
+	require.False(t, res.HasValue())
+	require.Equal(t, 1, calls)
+
+	res = optional.MapKeepNone(optional.Some(1), fn)
+	requireOptionalHasValue(t, "one", res)
+
+	res = optional.MapKeepNone(optional.Some(0), fn)
+	requireOptionalHasValue(t, "", res)
+	require.Equal(t, 3, calls)
+}
+
+func TestRequire3(t *testing.T) {
+	a, b, c, ok := optional.Require3(
+		optional.Some(1),
+		optional.Some("two"),
+		optional.Some(3.0),
+	)
+	require.True(t, ok)
+	require.Equal(t, 1, a)
+	require.Equal(t, "two", b)
+	require.Equal(t, 3.0, c)
+
+	a, b, c, ok = optional.Require3(
+		optional.None[int](),
+		optional.Some("two"),
+		optional.Some(3.0),
+	)
+	require.False(t, ok)
+	require.Zero(t, a)
+	require.Zero(t, b)
+	require.Zero(t, c)
+
+	a, b, c, ok = optional.Require3(
+		optional.Some(1),
+		optional.None[string](),
+		optional.Some(3.0),
+	)
+	require.False(t, ok)
+	require.Zero(t, a)
+	require.Zero(t, b)
+	require.Zero(t, c)
+
+	a, b, c, ok = optional.Require3(
+		optional.Some(1),
+		optional.Some("two"),
+		optional.None[float64](),
+	)
+	require.False(t, ok)
+	require.Zero(t, a)
+	require.Zero(t, b)
+	require.Zero(t, c)
+}
+
+func TestTry(t *testing.T) {
+	res := optional.Try(func() int { return 123 })
+	requireOptionalHasValue(t, 123, res)
+
+	res = optional.Try(func() int { panic("oops") })
+	require.False(t, res.HasValue())
+
+	var opt optional.Optional[int]
+	res = optional.Try(opt.Value)
+	require.False(t, res.HasValue())
+}
+
+func TestTryRecover(t *testing.T) {
+	var recovered []any
+	onPanic := func(r any) {
+		recovered = append(recovered, r)
+	}
+
+	res := optional.TryRecover(func() int { return 123 }, onPanic)
+	requireOptionalHasValue(t, 123, res)
+	require.Empty(t, recovered)
+
+	res = optional.TryRecover(func() int { panic("oops") }, onPanic)
+	require.False(t, res.HasValue())
+	require.Equal(t, []any{"oops"}, recovered)
+
+	res = optional.TryRecover(func() int { panic("oops") }, nil)
+	require.False(t, res.HasValue())
+}
+
+func TestTryErr(t *testing.T) {
+	res := optional.TryErr(func() (int, error) {
+		return strconv.Atoi("123")
+	})
+	requireOptionalHasValue(t, 123, res)
+
+	res = optional.TryErr(func() (int, error) {
+		return 123, strconv.ErrSyntax
+	})
+	require.False(t, res.HasValue())
+}
+
+func TestPipe(t *testing.T) {
+	var calls []string
+	step := func(name string, fn func(int) optional.Optional[int]) func(int) optional.Optional[int] {
+		return func(x int) optional.Optional[int] {
+			calls = append(calls, name)
+			return fn(x)
+		}
+	}
+
+	var (
+		double = step("double", func(x int) optional.Optional[int] {
+			return optional.Some(x * 2)
+		})
+		incr = step("incr", func(x int) optional.Optional[int] {
+			return optional.Some(x + 1)
+		})
+		nonzero = step("nonzero", func(x int) optional.Optional[int] {
+			if x == 0 {
+				return optional.None[int]()
+			}
+			return optional.Some(x)
+		})
+	)
+
+	requireOptionalHasValue(t, 123, optional.Pipe(optional.Some(123)))
+
+	res := optional.Pipe(optional.None[int](), double, incr)
+	require.False(t, res.HasValue())
+	require.Empty(t, calls)
+
+	res = optional.Pipe(optional.Some(1), double, nonzero, incr)
+	requireOptionalHasValue(t, 3, res)
+	require.Equal(t, []string{"double", "nonzero", "incr"}, calls)
+
+	calls = nil
+	res = optional.Pipe(optional.Some(0), double, nonzero, incr)
+	require.False(t, res.HasValue())
+	require.Equal(t, []string{"double", "nonzero"}, calls)
+}
+
+func TestZipOuter(t *testing.T) {
+	type pair = optional.Pair[optional.Optional[int], optional.Optional[string]]
+
+	res := optional.ZipOuter(optional.None[int](), optional.None[string]())
+	require.False(t, res.HasValue())
+
+	res = optional.ZipOuter(optional.Some(1), optional.None[string]())
+	requireOptionalHasValue(t, pair{
+		First:  optional.Some(1),
+		Second: optional.None[string](),
+	}, res)
+
+	res = optional.ZipOuter(optional.None[int](), optional.Some("a"))
+	requireOptionalHasValue(t, pair{
+		First:  optional.None[int](),
+		Second: optional.Some("a"),
+	}, res)
+
+	res = optional.ZipOuter(optional.Some(1), optional.Some("a"))
+	requireOptionalHasValue(t, pair{
+		First:  optional.Some(1),
+		Second: optional.Some("a"),
+	}, res)
+}
+
+func TestMapOrOpt(t *testing.T) {
+	var calls int
+	itoa := func(x int) string {
+		calls++
+		return strconv.Itoa(x)
+	}
+
+	res := optional.MapOrOpt(optional.Some(123), optional.Some("fallback"), itoa)
+	requireOptionalHasValue(t, "123", res)
+	require.Equal(t, 1, calls)
+
+	res = optional.MapOrOpt(optional.Some(123), optional.None[string](), itoa)
+	requireOptionalHasValue(t, "123", res)
+	require.Equal(t, 2, calls)
+
+	res = optional.MapOrOpt(optional.None[int](), optional.Some("fallback"), itoa)
+	requireOptionalHasValue(t, "fallback", res)
+
+	res = optional.MapOrOpt(optional.None[int](), optional.None[string](), itoa)
+	require.False(t, res.HasValue())
+	require.Equal(t, 2, calls)
+}
+
+func TestOrErr(t *testing.T) {
+	type errCode int
+
+	const (
+		errCodeOK errCode = iota
+		errCodeMissing
+	)
+
+	value, code := optional.OrErr(optional.Some(123), errCodeMissing)
+	require.Equal(t, 123, value)
+	require.Equal(t, errCodeOK, code)
+
+	value, code = optional.OrErr(optional.None[int](), errCodeMissing)
+	require.Zero(t, value)
+	require.Equal(t, errCodeMissing, code)
+}
+
+func TestMapOrElseOpt(t *testing.T) {
+	var (
+		fallbackCalls int
+		someFallback  = func() optional.Optional[string] {
+			fallbackCalls++
+			return optional.Some("fallback")
+		}
+		noneFallback = func() optional.Optional[string] {
+			fallbackCalls++
+			return optional.None[string]()
+		}
+	)
+
+	res := optional.MapOrElseOpt(optional.Some(123), someFallback, strconv.Itoa)
+	requireOptionalHasValue(t, "123", res)
+	require.Equal(t, 0, fallbackCalls)
+
+	res = optional.MapOrElseOpt(optional.None[int](), someFallback, strconv.Itoa)
+	requireOptionalHasValue(t, "fallback", res)
+	require.Equal(t, 1, fallbackCalls)
+
+	res = optional.MapOrElseOpt(optional.None[int](), noneFallback, strconv.Itoa)
+	require.False(t, res.HasValue())
+	require.Equal(t, 2, fallbackCalls)
+}
+
+func TestSwapIfDifferent(t *testing.T) {
+	var opt optional.Optional[int]
+
+	prev, changed := optional.SwapIfDifferent(&opt, 0)
+	require.True(t, changed)
+	require.False(t, prev.HasValue())
+	requireOptionalHasValue(t, 0, opt)
+
+	prev, changed = optional.SwapIfDifferent(&opt, 0)
+	require.False(t, changed)
+	requireOptionalHasValue(t, 0, prev)
+	requireOptionalHasValue(t, 0, opt)
+
+	prev, changed = optional.SwapIfDifferent(&opt, 123)
+	require.True(t, changed)
+	requireOptionalHasValue(t, 0, prev)
+	requireOptionalHasValue(t, 123, opt)
+}
+
+func TestAndThenOk(t *testing.T) {
+	var (
+		errMissing = errors.New("missing")
+		calls      int
+		atoi       = func(s string) (int, error) {
+			calls++
+			return strconv.Atoi(s)
+		}
+	)
+
+	value, err := optional.AndThenOk(optional.None[string](), errMissing, atoi)
+	require.ErrorIs(t, err, errMissing)
+	require.Zero(t, value)
+	require.Equal(t, 0, calls)
+
+	value, err = optional.AndThenOk(optional.Some("123"), errMissing, atoi)
+	require.NoError(t, err)
+	require.Equal(t, 123, value)
+
+	_, err = optional.AndThenOk(optional.Some("abc"), errMissing, atoi)
+	require.ErrorIs(t, err, strconv.ErrSyntax)
+	require.Equal(t, 2, calls)
+}
+
+func TestNormalize(t *testing.T) {
+	res := optional.Normalize(optional.Some[*int](nil))
+	require.False(t, res.HasValue())
+
+	x := 123
+	res = optional.Normalize(optional.Some(&x))
+	requireOptionalHasValue(t, &x, res)
+	require.Same(t, &x, res.Value())
+
+	res = optional.Normalize(optional.None[*int]())
+	require.False(t, res.HasValue())
+}
+
+func TestMapOrZero(t *testing.T) {
+	type wrapper struct {
+		Value string
+	}
+
+	var calls int
+	wrap := func(x int) wrapper {
+		calls++
+		return wrapper{Value: strconv.Itoa(x)}
+	}
+
+	require.Equal(t, "123", optional.MapOrZero(optional.Some(123), strconv.Itoa))
+	require.Equal(t, "", optional.MapOrZero(optional.None[int](), strconv.Itoa))
+
+	require.Equal(t, wrapper{Value: "123"}, optional.MapOrZero(optional.Some(123), wrap))
+	require.Equal(t, wrapper{}, optional.MapOrZero(optional.None[int](), wrap))
+	require.Equal(t, 1, calls)
+}
+
+func TestZipOrFunc(t *testing.T) {
+	var fillACalls, fillBCalls int
+	fillA := func() int {
+		fillACalls++
+		return -1
+	}
+	fillB := func() string {
+		fillBCalls++
+		return "-"
+	}
+
+	cases := []struct {
+		name       string
+		a          optional.Optional[int]
+		b          optional.Optional[string]
+		want       optional.Pair[int, string]
+		wantFillsA int
+		wantFillsB int
+	}{
+		{
+			name:       "both present",
+			a:          optional.Some(1),
+			b:          optional.Some("a"),
+			want:       optional.Pair[int, string]{First: 1, Second: "a"},
+			wantFillsA: 0,
+			wantFillsB: 0,
+		},
+		{
+			name:       "a absent",
+			a:          optional.None[int](),
+			b:          optional.Some("a"),
+			want:       optional.Pair[int, string]{First: -1, Second: "a"},
+			wantFillsA: 1,
+			wantFillsB: 0,
+		},
+		{
+			name:       "b absent",
+			a:          optional.Some(1),
+			b:          optional.None[string](),
+			want:       optional.Pair[int, string]{First: 1, Second: "-"},
+			wantFillsA: 0,
+			wantFillsB: 1,
+		},
+		{
+			name:       "both absent",
+			a:          optional.None[int](),
+			b:          optional.None[string](),
+			want:       optional.Pair[int, string]{First: -1, Second: "-"},
+			wantFillsA: 1,
+			wantFillsB: 1,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			fillACalls, fillBCalls = 0, 0
+			require.Equal(t, tt.want, optional.ZipOrFunc(tt.a, tt.b, fillA, fillB))
+			require.Equal(t, tt.wantFillsA, fillACalls)
+			require.Equal(t, tt.wantFillsB, fillBCalls)
+		})
+	}
+}
@@ -1,8 +1,12 @@
 module go.mway.dev/optional
 
-go 1.22.1
+go 1.24.0
 
-require github.com/stretchr/testify v1.9.0
+require (
+	github.com/google/go-cmp v0.7.0
+	github.com/stretchr/testify v1.9.0
+	google.golang.org/protobuf v1.36.12
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
@@ -0,0 +1,50 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional
+
+import (
+	"sync"
+)
+
+// A Guarded is an [Optional] protected by its own mutex, allowing individual
+// optional fields to be locked independently. The zero value of a Guarded
+// holds no value and is ready to use. A Guarded must not be copied after first
+// use.
+type Guarded[T any] struct {
+	mu  sync.Mutex
+	opt Optional[T]
+}
+
+// NewGuarded produces a [Guarded] holding the given [Optional].
+func NewGuarded[T any](opt Optional[T]) *Guarded[T] {
+	return &Guarded[T]{
+		opt: opt,
+	}
+}
+
+// Do calls fn with a pointer to the guarded [Optional] while holding the lock,
+// allowing arbitrary read-modify-write operations to be performed atomically.
+// The pointer must not be retained after fn returns.
+func (g *Guarded[T]) Do(fn func(*Optional[T])) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	fn(&g.opt)
+}
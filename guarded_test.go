@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+)
+
+func TestNewGuarded(t *testing.T) {
+	guarded := optional.NewGuarded(optional.Some(123))
+	guarded.Do(func(opt *optional.Optional[int]) {
+		requireOptionalHasValue(t, 123, *opt)
+	})
+}
+
+func TestGuarded_Do(t *testing.T) {
+	const (
+		workers    = 8
+		iterations = 1000
+	)
+
+	var (
+		guarded optional.Guarded[int]
+		wg      sync.WaitGroup
+	)
+
+	guarded.Do(func(opt *optional.Optional[int]) {
+		require.False(t, opt.HasValue())
+	})
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range iterations {
+				guarded.Do(func(opt *optional.Optional[int]) {
+					*opt = optional.Some(opt.ValueOr(0) + 1)
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	guarded.Do(func(opt *optional.Optional[int]) {
+		requireOptionalHasValue(t, workers*iterations, *opt)
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+// Package optreflect provides reflection helpers for working with
+// [optional.Optional] values whose type parameter is not known statically.
+package optreflect
+
+import (
+	"reflect"
+	"strings"
+
+	"go.mway.dev/optional"
+)
+
+var optionalPkgPath = reflect.TypeFor[optional.Optional[struct{}]]().PkgPath()
+
+// IsOptional reports whether t is an instantiation of [optional.Optional].
+func IsOptional(t reflect.Type) bool {
+	return t != nil &&
+		t.Kind() == reflect.Struct &&
+		t.PkgPath() == optionalPkgPath &&
+		strings.HasPrefix(t.Name(), "Optional[")
+}
+
+// Get returns the result of calling Get on rv, which must hold an
+// [optional.Optional] or a non-nil pointer to one.
+func Get(rv reflect.Value) (any, bool) {
+	if rv.Kind() != reflect.Pointer {
+		// Optional methods have pointer receivers, so work on an addressable
+		// copy of the given value.
+		ptr := reflect.New(rv.Type())
+		ptr.Elem().Set(rv)
+		rv = ptr
+	}
+
+	out := rv.MethodByName("Get").Call(nil)
+	return out[0].Interface(), out[1].Bool()
+}
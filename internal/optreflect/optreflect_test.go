@@ -0,0 +1,58 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optreflect_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+	"go.mway.dev/optional/internal/optreflect"
+)
+
+type notOptional struct{}
+
+func TestIsOptional(t *testing.T) {
+	require.True(t, optreflect.IsOptional(reflect.TypeFor[optional.Optional[int]]()))
+	require.True(t, optreflect.IsOptional(reflect.TypeFor[optional.Optional[error]]()))
+
+	require.False(t, optreflect.IsOptional(nil))
+	require.False(t, optreflect.IsOptional(reflect.TypeFor[int]()))
+	require.False(t, optreflect.IsOptional(reflect.TypeFor[notOptional]()))
+	require.False(t, optreflect.IsOptional(reflect.TypeFor[*optional.Optional[int]]()))
+	require.False(t, optreflect.IsOptional(reflect.TypeFor[optional.Lazy[int]]()))
+}
+
+func TestGet(t *testing.T) {
+	value, ok := optreflect.Get(reflect.ValueOf(optional.Some(123)))
+	require.True(t, ok)
+	require.Equal(t, 123, value)
+
+	value, ok = optreflect.Get(reflect.ValueOf(optional.None[string]()))
+	require.False(t, ok)
+	require.Equal(t, "", value)
+
+	opt := optional.Some("foo")
+	value, ok = optreflect.Get(reflect.ValueOf(&opt))
+	require.True(t, ok)
+	require.Equal(t, "foo", value)
+}
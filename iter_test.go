@@ -0,0 +1,51 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+)
+
+func TestSeq(t *testing.T) {
+	var (
+		a = optional.Some(1)
+		b = optional.None[int]()
+		c = optional.Some(3)
+		d = optional.Some(0)
+	)
+
+	require.Empty(t, slices.Collect(optional.Seq[int]()))
+	require.Empty(t, slices.Collect(optional.Seq(b, b)))
+	require.Equal(t, []int{1, 3, 0}, slices.Collect(optional.Seq(a, b, c, d)))
+
+	var have []int
+	for x := range optional.Seq(a, b, c, d) {
+		have = append(have, x)
+		if x == 3 {
+			break
+		}
+	}
+	require.Equal(t, []int{1, 3}, have)
+}
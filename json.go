@@ -0,0 +1,213 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var (
+	_ json.Marshaler   = Nullable[any]{}
+	_ json.Unmarshaler = (*Nullable[any])(nil)
+	_ json.Marshaler   = Raw{}
+	_ json.Unmarshaler = (*Raw)(nil)
+
+	jsonNull = []byte("null")
+
+	// nullSentinels maps the reflect.Type of T to the sentinel configured for
+	// Nullable[T] by SetNullSentinel.
+	nullSentinels sync.Map
+)
+
+// DecodeArray reads a JSON array from dec one element at a time, producing an
+// [Optional] for each element: null elements produce an [Optional] that holds
+// no value, and all other elements are decoded into a value of type T. On
+// success, dec is positioned immediately after the closing bracket.
+func DecodeArray[T any](dec *json.Decoder) ([]Optional[T], error) {
+	if err := expectDelim(dec, '['); err != nil {
+		return nil, err
+	}
+
+	var opts []Optional[T]
+	for dec.More() {
+		var value *T
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+
+		if value == nil {
+			opts = append(opts, None[T]())
+		} else {
+			opts = append(opts, Some(*value))
+		}
+	}
+
+	if err := expectDelim(dec, ']'); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != want {
+		return fmt.Errorf("optional: expected JSON delimiter %q, got %v", want, tok)
+	}
+	return nil
+}
+
+// A Nullable is an [Optional] that encodes to and decodes from JSON, using a
+// sentinel value (null by default; see [SetNullSentinel]) to represent
+// absence. Note that a held value that encodes to the sentinel decodes as
+// absent.
+type Nullable[T any] struct {
+	Optional Optional[T]
+}
+
+// SetNullSentinel configures the JSON value used by every [Nullable] of type T
+// to represent absence, such as `"N/A"` or `{}`. A nil sentinel restores the
+// default, null. Regardless of the sentinel, null always decodes as absent.
+// SetNullSentinel returns an error if sentinel is not valid JSON.
+func SetNullSentinel[T any](sentinel []byte) error {
+	key := reflect.TypeFor[T]()
+	if sentinel == nil {
+		nullSentinels.Delete(key)
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, sentinel); err != nil {
+		return fmt.Errorf("optional: invalid null sentinel: %w", err)
+	}
+
+	nullSentinels.Store(key, buf.Bytes())
+	return nil
+}
+
+// MarshalJSON implements [json.Marshaler]. A held value is encoded as JSON; if
+// no value is held, the configured sentinel is emitted.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	value, ok := n.Optional.Get()
+	if !ok {
+		return bytes.Clone(nullSentinel[T]()), nil
+	}
+	return json.Marshal(value)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler]. Both null and the configured
+// sentinel decode as an [Optional] that holds no value; anything else is
+// decoded into a value of type T.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return err
+	}
+
+	if compact := buf.Bytes(); bytes.Equal(compact, jsonNull) ||
+		bytes.Equal(compact, nullSentinel[T]()) {
+		n.Optional = None[T]()
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	n.Optional = Some(value)
+	return nil
+}
+
+func nullSentinel[T any]() []byte {
+	if sentinel, ok := nullSentinels.Load(reflect.TypeFor[T]()); ok {
+		if raw, ok := sentinel.([]byte); ok {
+			return raw
+		}
+	}
+	return jsonNull
+}
+
+// GetJSON walks the given object keys in the JSON-encoded data and returns an
+// [Optional] holding the value found at the end of path, decoded into a value
+// of type T. If data is not valid JSON, any segment of path is missing or is
+// not an object, the value is null, or it cannot be decoded into a value of
+// type T, GetJSON returns an [Optional] that holds no value.
+func GetJSON[T any](data []byte, path ...string) Optional[T] {
+	raw := json.RawMessage(data)
+	for _, key := range path {
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return None[T]()
+		}
+
+		next, ok := obj[key]
+		if !ok {
+			return None[T]()
+		}
+		raw = next
+	}
+
+	var value *T
+	if err := json.Unmarshal(raw, &value); err != nil || value == nil {
+		return None[T]()
+	}
+	return Some(*value)
+}
+
+// A Raw is an optional [json.RawMessage] that distinguishes a missing JSON
+// field (which decodes as an [Optional] that holds no value) from a present
+// one, whose raw bytes are kept verbatim for deferred decoding. A present null
+// decodes as an [Optional] holding `null`. To keep absent fields absent when
+// encoding, tag Raw fields with `omitzero`.
+type Raw struct {
+	Optional Optional[json.RawMessage]
+}
+
+// IsZero reports whether no value is held. It allows Raw fields tagged with
+// `omitzero` to be omitted when encoding.
+func (r Raw) IsZero() bool {
+	return !r.Optional.HasValue()
+}
+
+// MarshalJSON implements [json.Marshaler]. The held raw bytes are emitted
+// as-is; if no value is held, null is emitted.
+func (r Raw) MarshalJSON() ([]byte, error) {
+	raw, ok := r.Optional.Get()
+	if !ok || raw == nil {
+		return bytes.Clone(jsonNull), nil
+	}
+	return raw, nil
+}
+
+// UnmarshalJSON implements [json.Unmarshaler]. It keeps a copy of data,
+// including a null literal, as the held value.
+func (r *Raw) UnmarshalJSON(data []byte) error {
+	r.Optional = Some(json.RawMessage(bytes.Clone(data)))
+	return nil
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+)
+
+func TestDecodeArray(t *testing.T) {
+	type record struct {
+		Name string `json:"name"`
+	}
+
+	dec := json.NewDecoder(strings.NewReader(
+		`[{"name":"a"}, null, {"name":""}, null] {"name":"next"}`,
+	))
+
+	opts, err := optional.DecodeArray[record](dec)
+	require.NoError(t, err)
+	require.Equal(t, []optional.Optional[record]{
+		optional.Some(record{Name: "a"}),
+		optional.None[record](),
+		optional.Some(record{}),
+		optional.None[record](),
+	}, opts)
+
+	var next record
+	require.NoError(t, dec.Decode(&next))
+	require.Equal(t, record{Name: "next"}, next)
+
+	dec = json.NewDecoder(strings.NewReader(`[]`))
+	opts, err = optional.DecodeArray[record](dec)
+	require.NoError(t, err)
+	require.Empty(t, opts)
+
+	dec = json.NewDecoder(strings.NewReader(`[1, null, 0]`))
+	ints, err := optional.DecodeArray[int](dec)
+	require.NoError(t, err)
+	require.Equal(t, []optional.Optional[int]{
+		optional.Some(1),
+		optional.None[int](),
+		optional.Some(0),
+	}, ints)
+}
+
+func TestDecodeArray_Errors(t *testing.T) {
+	for _, input := range []string{
+		``,
+		`{}`,
+		`[1, "a"]`,
+		`[1, 2`,
+	} {
+		t.Run(input, func(t *testing.T) {
+			dec := json.NewDecoder(strings.NewReader(input))
+			_, err := optional.DecodeArray[int](dec)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestNullable(t *testing.T) {
+	type record struct {
+		Name  optional.Nullable[string] `json:"name"`
+		Count optional.Nullable[int]    `json:"count"`
+	}
+
+	data, err := json.Marshal(record{})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name":null,"count":null}`, string(data))
+
+	var have record
+	require.NoError(t, json.Unmarshal(data, &have))
+	require.Equal(t, record{}, have)
+
+	want := record{
+		Name:  optional.Nullable[string]{Optional: optional.Some("")},
+		Count: optional.Nullable[int]{Optional: optional.Some(0)},
+	}
+	data, err = json.Marshal(want)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"","count":0}`, string(data))
+
+	have = record{}
+	require.NoError(t, json.Unmarshal(data, &have))
+	require.Equal(t, want, have)
+
+	require.Error(t, json.Unmarshal([]byte(`{"count":"x"}`), &have))
+}
+
+func TestNullable_Sentinel(t *testing.T) {
+	type legacy struct {
+		Value string
+	}
+	type record struct {
+		Name  optional.Nullable[string] `json:"name"`
+		Inner optional.Nullable[legacy] `json:"inner"`
+	}
+
+	require.NoError(t, optional.SetNullSentinel[string]([]byte(`"N/A"`)))
+	require.NoError(t, optional.SetNullSentinel[legacy]([]byte(`{ }`)))
+	t.Cleanup(func() {
+		require.NoError(t, optional.SetNullSentinel[string](nil))
+		require.NoError(t, optional.SetNullSentinel[legacy](nil))
+	})
+
+	data, err := json.Marshal(record{})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"N/A","inner":{}}`, string(data))
+
+	var have record
+	require.NoError(t, json.Unmarshal(data, &have))
+	require.Equal(t, record{}, have)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"name":null,"inner":null}`), &have))
+	require.Equal(t, record{}, have)
+
+	want := record{
+		Name:  optional.Nullable[string]{Optional: optional.Some("foo")},
+		Inner: optional.Nullable[legacy]{Optional: optional.Some(legacy{Value: "x"})},
+	}
+	data, err = json.Marshal(want)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"foo","inner":{"Value":"x"}}`, string(data))
+
+	have = record{}
+	require.NoError(t, json.Unmarshal(data, &have))
+	require.Equal(t, want, have)
+
+	// Other types are unaffected.
+	data, err = json.Marshal(optional.Nullable[int]{})
+	require.NoError(t, err)
+	require.Equal(t, "null", string(data))
+
+	require.Error(t, optional.SetNullSentinel[int]([]byte(`{`)))
+}
+
+func TestGetJSON(t *testing.T) {
+	data := []byte(`{
+		"server": {
+			"timeout": 30,
+			"name": "foo",
+			"tls": {"enabled": true},
+			"proxy": null
+		}
+	}`)
+
+	requireOptionalHasValue(t, 30, optional.GetJSON[int](data, "server", "timeout"))
+	requireOptionalHasValue(t, "foo", optional.GetJSON[string](data, "server", "name"))
+	requireOptionalHasValue(t, true, optional.GetJSON[bool](data, "server", "tls", "enabled"))
+	requireOptionalHasValue(
+		t,
+		map[string]bool{"enabled": true},
+		optional.GetJSON[map[string]bool](data, "server", "tls"),
+	)
+
+	for name, opt := range map[string]optional.Optional[int]{
+		"missing leaf":         optional.GetJSON[int](data, "server", "port"),
+		"missing intermediate": optional.GetJSON[int](data, "client", "timeout"),
+		"non-object segment":   optional.GetJSON[int](data, "server", "timeout", "x"),
+		"type mismatch":        optional.GetJSON[int](data, "server", "name"),
+		"null":                 optional.GetJSON[int](data, "server", "proxy"),
+		"invalid json":         optional.GetJSON[int]([]byte(`{`), "server"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.False(t, opt.HasValue())
+		})
+	}
+}
+
+func TestRaw(t *testing.T) {
+	type message struct {
+		Object optional.Raw `json:"object,omitzero"`
+		Null   optional.Raw `json:"null,omitzero"`
+		Number optional.Raw `json:"number,omitzero"`
+		Absent optional.Raw `json:"absent,omitzero"`
+	}
+
+	input := `{"object":{"a":[1,2]},"null":null,"number":123}`
+
+	var have message
+	require.NoError(t, json.Unmarshal([]byte(input), &have))
+
+	requireOptionalHasValue(t, json.RawMessage(`{"a":[1,2]}`), have.Object.Optional)
+	requireOptionalHasValue(t, json.RawMessage(`null`), have.Null.Optional)
+	requireOptionalHasValue(t, json.RawMessage(`123`), have.Number.Optional)
+	require.False(t, have.Absent.Optional.HasValue())
+
+	data, err := json.Marshal(have)
+	require.NoError(t, err)
+	require.JSONEq(t, input, string(data))
+	require.NotContains(t, string(data), "absent")
+
+	data, err = json.Marshal(optional.Raw{})
+	require.NoError(t, err)
+	require.Equal(t, "null", string(data))
+}
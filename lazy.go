@@ -0,0 +1,74 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// A Lazy is a value that is computed at most once, on first use. Before it is
+// computed, its cached state is an [Optional] that holds no value. A Lazy is
+// safe for concurrent use.
+//
+// Only a successful computation is cached: if the producer panics, the panic
+// propagates to the caller of [Lazy.Get] and the value remains uncomputed, so
+// a later call to Get runs the producer again.
+type Lazy[T any] struct {
+	fn    func() T
+	mu    sync.Mutex
+	done  atomic.Bool
+	value Optional[T]
+}
+
+// NewLazy produces a [Lazy] whose value is computed by fn.
+func NewLazy[T any](fn func() T) *Lazy[T] {
+	return &Lazy[T]{
+		fn: fn,
+	}
+}
+
+// Get returns the value, computing it if it has not yet been computed.
+// Concurrent callers block until the first computation completes. If the
+// producer panics, Get propagates the panic and the next call retries.
+func (l *Lazy[T]) Get() T {
+	if l.done.Load() {
+		return l.value.value
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.done.Load() {
+		l.value = Some(l.fn())
+		l.done.Store(true)
+	}
+	return l.value.value
+}
+
+// Peek returns the cached value without computing it. If the value has not yet
+// been computed, Peek returns an [Optional] that holds no value.
+func (l *Lazy[T]) Peek() Optional[T] {
+	if !l.done.Load() {
+		return None[T]()
+	}
+	return l.value
+}
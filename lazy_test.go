@@ -0,0 +1,93 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+)
+
+func TestLazy(t *testing.T) {
+	var (
+		calls atomic.Int64
+		lazy  = optional.NewLazy(func() int {
+			calls.Add(1)
+			return 123
+		})
+	)
+
+	peek := lazy.Peek()
+	require.False(t, peek.HasValue())
+	require.Equal(t, int64(0), calls.Load())
+
+	var (
+		wg      sync.WaitGroup
+		start   = make(chan struct{})
+		results = make([]int, 16)
+	)
+
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_ = lazy.Peek()
+			results[i] = lazy.Get()
+		}()
+	}
+
+	close(start)
+	wg.Wait()
+
+	for _, res := range results {
+		require.Equal(t, 123, res)
+	}
+	require.Equal(t, int64(1), calls.Load())
+	require.Equal(t, 123, lazy.Get())
+	require.Equal(t, int64(1), calls.Load())
+	requireOptionalHasValue(t, 123, lazy.Peek())
+}
+
+func TestLazy_Panic(t *testing.T) {
+	var (
+		calls atomic.Int64
+		lazy  = optional.NewLazy(func() int {
+			if calls.Add(1) == 1 {
+				panic("boom")
+			}
+			return 123
+		})
+	)
+
+	require.PanicsWithValue(t, "boom", func() {
+		lazy.Get()
+	})
+	peek := lazy.Peek()
+	require.False(t, peek.HasValue())
+
+	require.Equal(t, 123, lazy.Get())
+	require.Equal(t, int64(2), calls.Load())
+	requireOptionalHasValue(t, 123, lazy.Peek())
+}
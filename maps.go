@@ -0,0 +1,72 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional
+
+// ModifyMap applies fn to a copy of the value held by m[key] and writes the
+// result back to m. If key is missing from m, or its [Optional] holds no
+// value, m is left unchanged and fn is not called.
+func ModifyMap[K comparable, T any](m map[K]Optional[T], key K, fn func(*T)) {
+	opt, ok := m[key]
+	if !ok || !opt.isset {
+		return
+	}
+
+	fn(&opt.value)
+	m[key] = opt
+}
+
+// MergeMaps merges src into dst in place. For each key in src whose [Optional]
+// holds a value, dst[key] is set to that [Optional]; keys in src whose
+// [Optional] holds no value leave dst unchanged.
+func MergeMaps[K comparable, V any](dst map[K]Optional[V], src map[K]Optional[V]) {
+	for key, opt := range src {
+		if opt.isset {
+			dst[key] = opt
+		}
+	}
+}
+
+// ZipToMap returns a map holding a single entry mapping the value held by k to
+// the value held by v if both hold values, or an empty map otherwise.
+func ZipToMap[K comparable, V any](k Optional[K], v Optional[V]) map[K]V {
+	if !k.isset || !v.isset {
+		return map[K]V{}
+	}
+	return map[K]V{k.value: v.value}
+}
+
+// MapValues returns a map with the same keys as m, where each value held by m
+// is mapped to a value of type Out using fn. Entries that hold no value remain
+// empty in the result, and fn is not called for them.
+func MapValues[K comparable, In any, Out any](
+	m map[K]Optional[In],
+	fn func(In) Out,
+) map[K]Optional[Out] {
+	res := make(map[K]Optional[Out], len(m))
+	for key, opt := range m {
+		if opt.isset {
+			res[key] = Some(fn(opt.value))
+		} else {
+			res[key] = None[Out]()
+		}
+	}
+	return res
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+)
+
+func TestModifyMap(t *testing.T) {
+	var (
+		calls int
+		incr  = func(x *int) {
+			calls++
+			*x++
+		}
+		m = map[string]optional.Optional[int]{
+			"some": optional.Some(123),
+			"none": optional.None[int](),
+		}
+	)
+
+	optional.ModifyMap(m, "some", incr)
+	requireOptionalHasValue(t, 124, m["some"])
+	require.Equal(t, 1, calls)
+
+	optional.ModifyMap(m, "none", incr)
+	require.Equal(t, optional.None[int](), m["none"])
+	require.Equal(t, 1, calls)
+
+	optional.ModifyMap(m, "missing", incr)
+	require.NotContains(t, m, "missing")
+	require.Len(t, m, 2)
+	require.Equal(t, 1, calls)
+}
+
+func TestMergeMaps(t *testing.T) {
+	dst := map[string]optional.Optional[int]{
+		"a": optional.Some(1),
+		"b": optional.Some(2),
+		"c": optional.None[int](),
+	}
+	src := map[string]optional.Optional[int]{
+		"a": optional.Some(10),
+		"b": optional.None[int](),
+		"c": optional.Some(30),
+		"d": optional.Some(40),
+		"e": optional.None[int](),
+	}
+
+	optional.MergeMaps(dst, src)
+	require.Equal(t, map[string]optional.Optional[int]{
+		"a": optional.Some(10),
+		"b": optional.Some(2),
+		"c": optional.Some(30),
+		"d": optional.Some(40),
+	}, dst)
+}
+
+func TestZipToMap(t *testing.T) {
+	require.Equal(
+		t,
+		map[string]int{"a": 1},
+		optional.ZipToMap(optional.Some("a"), optional.Some(1)),
+	)
+	require.Equal(
+		t,
+		map[string]int{"": 0},
+		optional.ZipToMap(optional.Some(""), optional.Some(0)),
+	)
+
+	for _, m := range []map[string]int{
+		optional.ZipToMap(optional.None[string](), optional.Some(1)),
+		optional.ZipToMap(optional.Some("a"), optional.None[int]()),
+		optional.ZipToMap(optional.None[string](), optional.None[int]()),
+	} {
+		require.NotNil(t, m)
+		require.Empty(t, m)
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	var calls []int
+	fn := func(x int) string {
+		calls = append(calls, x)
+		return strconv.Itoa(x)
+	}
+
+	require.Empty(t, optional.MapValues(map[string]optional.Optional[int]{}, fn))
+
+	res := optional.MapValues(map[string]optional.Optional[int]{
+		"a": optional.Some(1),
+		"b": optional.None[int](),
+		"c": optional.Some(0),
+	}, fn)
+	require.Equal(t, map[string]optional.Optional[string]{
+		"a": optional.Some("1"),
+		"b": optional.None[string](),
+		"c": optional.Some("0"),
+	}, res)
+	require.ElementsMatch(t, []int{1, 0}, calls)
+}
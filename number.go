@@ -0,0 +1,188 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional
+
+// Signed is a constraint that permits any signed integer type.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is a constraint that permits any unsigned integer type.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	Integer | Float
+}
+
+// Sum returns an [Optional] holding the sum of the values held by opts, or an
+// [Optional] that holds no value if none of opts hold a value.
+func Sum[T Number](opts []Optional[T]) Optional[T] {
+	return ReduceOr(opts, func(a T, b T) T {
+		return a + b
+	})
+}
+
+// Product returns an [Optional] holding the product of the values held by
+// opts, or an [Optional] that holds no value if none of opts hold a value.
+func Product[T Number](opts []Optional[T]) Optional[T] {
+	return ReduceOr(opts, func(a T, b T) T {
+		return a * b
+	})
+}
+
+// Average returns an [Optional] holding the arithmetic mean of the values held
+// by opts, or an [Optional] that holds no value if none of opts hold a value.
+// Optionals that hold no value do not count toward the mean.
+func Average[T Number](opts []Optional[T]) Optional[float64] {
+	var (
+		sum   float64
+		count int
+	)
+
+	for _, opt := range opts {
+		if opt.isset {
+			sum += float64(opt.value)
+			count++
+		}
+	}
+
+	if count == 0 {
+		return None[float64]()
+	}
+	return Some(sum / float64(count))
+}
+
+// ToInt converts the value held by o to an int, or returns an [Optional] that
+// holds no value if o holds no value. Conversions follow Go's conversion
+// rules: floating-point values are truncated toward zero, and out-of-range
+// values are not detected (see [ToIntChecked]).
+func ToInt[T Number](o Optional[T]) Optional[int] {
+	return convert[int](o)
+}
+
+// ToInt64 converts the value held by o to an int64, or returns an [Optional]
+// that holds no value if o holds no value. Conversions follow the same rules
+// as [ToInt].
+func ToInt64[T Number](o Optional[T]) Optional[int64] {
+	return convert[int64](o)
+}
+
+// ToFloat64 converts the value held by o to a float64, or returns an
+// [Optional] that holds no value if o holds no value. Integers that cannot be
+// exactly represented as a float64 are rounded.
+func ToFloat64[T Number](o Optional[T]) Optional[float64] {
+	return convert[float64](o)
+}
+
+func convert[Out Number, In Number](o Optional[In]) Optional[Out] {
+	if !o.isset {
+		return None[Out]()
+	}
+	return Some(Out(o.value))
+}
+
+// ToIntChecked converts the value held by o to an int, or returns an
+// [Optional] that holds no value if o holds no value or the held value cannot
+// be represented as an int.
+func ToIntChecked[T Integer](o Optional[T]) Optional[int] {
+	return convertChecked[int](o)
+}
+
+// ToInt32Checked converts the value held by o to an int32, or returns an
+// [Optional] that holds no value if o holds no value or the held value cannot
+// be represented as an int32.
+func ToInt32Checked[T Integer](o Optional[T]) Optional[int32] {
+	return convertChecked[int32](o)
+}
+
+// ToInt64Checked converts the value held by o to an int64, or returns an
+// [Optional] that holds no value if o holds no value or the held value cannot
+// be represented as an int64.
+func ToInt64Checked[T Integer](o Optional[T]) Optional[int64] {
+	return convertChecked[int64](o)
+}
+
+// ToUintChecked converts the value held by o to a uint, or returns an
+// [Optional] that holds no value if o holds no value or the held value cannot
+// be represented as a uint.
+func ToUintChecked[T Integer](o Optional[T]) Optional[uint] {
+	return convertChecked[uint](o)
+}
+
+// ToUint32Checked converts the value held by o to a uint32, or returns an
+// [Optional] that holds no value if o holds no value or the held value cannot
+// be represented as a uint32.
+func ToUint32Checked[T Integer](o Optional[T]) Optional[uint32] {
+	return convertChecked[uint32](o)
+}
+
+// ToUint64Checked converts the value held by o to a uint64, or returns an
+// [Optional] that holds no value if o holds no value or the held value cannot
+// be represented as a uint64.
+func ToUint64Checked[T Integer](o Optional[T]) Optional[uint64] {
+	return convertChecked[uint64](o)
+}
+
+func convertChecked[Out Integer, In Integer](o Optional[In]) Optional[Out] {
+	if !o.isset {
+		return None[Out]()
+	}
+
+	// A conversion is exact if and only if it round-trips and preserves sign;
+	// the latter catches reinterpretation between signed and unsigned types.
+	out := Out(o.value)
+	if In(out) != o.value || (out < 0) != (o.value < 0) {
+		return None[Out]()
+	}
+	return Some(out)
+}
+
+// Div returns an [Optional] holding a divided by b, or an [Optional] that holds
+// no value if b is zero. This applies to floating-point types as well, so
+// division by zero never produces an infinity.
+func Div[T Number](a T, b T) Optional[T] {
+	if b == 0 {
+		return None[T]()
+	}
+	return Some(a / b)
+}
+
+// Mod returns an [Optional] holding the remainder of a divided by b, or an
+// [Optional] that holds no value if b is zero.
+func Mod[T Integer](a T, b T) Optional[T] {
+	if b == 0 {
+		return None[T]()
+	}
+	return Some(a % b)
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional_test
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+)
+
+func TestSum(t *testing.T) {
+	res := optional.Sum[int](nil)
+	require.False(t, res.HasValue())
+
+	res = optional.Sum(optional.NoneSlice[int](3))
+	require.False(t, res.HasValue())
+
+	res = optional.Sum([]optional.Optional[int]{
+		optional.Some(1),
+		optional.None[int](),
+		optional.Some(2),
+		optional.Some(3),
+	})
+	requireOptionalHasValue(t, 6, res)
+
+	fres := optional.Sum([]optional.Optional[float64]{
+		optional.None[float64](),
+		optional.Some(1.5),
+		optional.Some(2.25),
+	})
+	requireOptionalHasValue(t, 3.75, fres)
+}
+
+func TestProduct(t *testing.T) {
+	res := optional.Product[int](nil)
+	require.False(t, res.HasValue())
+
+	res = optional.Product(optional.NoneSlice[int](3))
+	require.False(t, res.HasValue())
+
+	res = optional.Product([]optional.Optional[int]{
+		optional.Some(2),
+		optional.None[int](),
+		optional.Some(3),
+		optional.Some(4),
+	})
+	requireOptionalHasValue(t, 24, res)
+
+	fres := optional.Product([]optional.Optional[float64]{
+		optional.None[float64](),
+		optional.Some(1.5),
+		optional.Some(2.0),
+	})
+	requireOptionalHasValue(t, 3.0, fres)
+}
+
+func TestAverage(t *testing.T) {
+	res := optional.Average[int](nil)
+	require.False(t, res.HasValue())
+
+	res = optional.Average(optional.NoneSlice[int](3))
+	require.False(t, res.HasValue())
+
+	res = optional.Average([]optional.Optional[int]{
+		optional.None[int](),
+		optional.Some(3),
+		optional.None[int](),
+	})
+	requireOptionalHasValue(t, 3.0, res)
+
+	res = optional.Average([]optional.Optional[int]{
+		optional.Some(1),
+		optional.None[int](),
+		optional.Some(2),
+		optional.None[int](),
+		optional.Some(6),
+	})
+	requireOptionalHasValue(t, 3.0, res)
+
+	res = optional.Average([]optional.Optional[float32]{
+		optional.Some[float32](1),
+		optional.Some[float32](2),
+	})
+	requireOptionalHasValue(t, 1.5, res)
+}
+
+func TestToInt(t *testing.T) {
+	res := optional.ToInt(optional.None[float64]())
+	require.False(t, res.HasValue())
+
+	requireOptionalHasValue(t, 123, optional.ToInt(optional.Some[int8](123)))
+	requireOptionalHasValue(t, 123, optional.ToInt(optional.Some[uint32](123)))
+	requireOptionalHasValue(t, 1, optional.ToInt(optional.Some(1.9)))
+	requireOptionalHasValue(t, -1, optional.ToInt(optional.Some(-1.9)))
+}
+
+func TestToInt64(t *testing.T) {
+	res := optional.ToInt64(optional.None[int]())
+	require.False(t, res.HasValue())
+
+	requireOptionalHasValue(t, int64(-123), optional.ToInt64(optional.Some[int16](-123)))
+	requireOptionalHasValue(t, int64(2), optional.ToInt64(optional.Some[float32](2.5)))
+}
+
+func TestToFloat64(t *testing.T) {
+	res := optional.ToFloat64(optional.None[int]())
+	require.False(t, res.HasValue())
+
+	requireOptionalHasValue(t, 123.0, optional.ToFloat64(optional.Some(123)))
+	requireOptionalHasValue(t, 1.5, optional.ToFloat64(optional.Some[float32](1.5)))
+}
+
+func TestToIntChecked(t *testing.T) {
+	requireNone(t, optional.ToIntChecked(optional.None[int64]()))
+	requireOptionalHasValue(t, 123, optional.ToIntChecked(optional.Some[int8](123)))
+	requireOptionalHasValue(t, -123, optional.ToIntChecked(optional.Some[int64](-123)))
+	requireOptionalHasValue(
+		t,
+		math.MaxInt32,
+		optional.ToIntChecked(optional.Some[int64](math.MaxInt32)),
+	)
+	requireOptionalHasValue(
+		t,
+		math.MinInt32,
+		optional.ToIntChecked(optional.Some[int64](math.MinInt32)),
+	)
+	requireNone(t, optional.ToIntChecked(optional.Some[uint64](math.MaxUint64)))
+	requireNone(t, optional.ToIntChecked(optional.Some[uint](math.MaxUint)))
+
+	// Values beyond 32 bits overflow int only on 32-bit targets.
+	big := int64(math.MaxInt32) + 1
+	res := optional.ToIntChecked(optional.Some(big))
+	if strconv.IntSize == 32 {
+		requireNone(t, res)
+	} else {
+		requireOptionalHasValue(t, int(big), res)
+	}
+}
+
+func TestToInt32Checked(t *testing.T) {
+	requireNone(t, optional.ToInt32Checked(optional.None[int]()))
+	requireOptionalHasValue(
+		t,
+		int32(math.MaxInt32),
+		optional.ToInt32Checked(optional.Some[int64](math.MaxInt32)),
+	)
+	requireOptionalHasValue(
+		t,
+		int32(math.MinInt32),
+		optional.ToInt32Checked(optional.Some[int64](math.MinInt32)),
+	)
+	requireNone(t, optional.ToInt32Checked(optional.Some[int64](math.MaxInt32+1)))
+	requireNone(t, optional.ToInt32Checked(optional.Some[int64](math.MinInt32-1)))
+	requireNone(t, optional.ToInt32Checked(optional.Some[uint32](math.MaxInt32+1)))
+	requireOptionalHasValue(
+		t,
+		int32(math.MaxInt32),
+		optional.ToInt32Checked(optional.Some[uint32](math.MaxInt32)),
+	)
+}
+
+func TestToInt64Checked(t *testing.T) {
+	requireNone(t, optional.ToInt64Checked(optional.None[uint64]()))
+	requireOptionalHasValue(
+		t,
+		int64(math.MaxInt64),
+		optional.ToInt64Checked(optional.Some[uint64](math.MaxInt64)),
+	)
+	requireNone(t, optional.ToInt64Checked(optional.Some[uint64](math.MaxInt64+1)))
+	requireOptionalHasValue(
+		t,
+		int64(math.MinInt64),
+		optional.ToInt64Checked(optional.Some[int64](math.MinInt64)),
+	)
+}
+
+func TestToUintChecked(t *testing.T) {
+	requireNone(t, optional.ToUintChecked(optional.None[int]()))
+	requireOptionalHasValue(t, uint(0), optional.ToUintChecked(optional.Some(0)))
+	requireOptionalHasValue(t, uint(123), optional.ToUintChecked(optional.Some(123)))
+	requireNone(t, optional.ToUintChecked(optional.Some(-1)))
+	requireNone(t, optional.ToUintChecked(optional.Some[int8](math.MinInt8)))
+}
+
+func TestToUint32Checked(t *testing.T) {
+	requireNone(t, optional.ToUint32Checked(optional.None[int]()))
+	requireOptionalHasValue(
+		t,
+		uint32(math.MaxUint32),
+		optional.ToUint32Checked(optional.Some[uint64](math.MaxUint32)),
+	)
+	requireNone(t, optional.ToUint32Checked(optional.Some[uint64](math.MaxUint32+1)))
+	requireNone(t, optional.ToUint32Checked(optional.Some[int32](-1)))
+}
+
+func TestToUint64Checked(t *testing.T) {
+	requireNone(t, optional.ToUint64Checked(optional.None[int64]()))
+	requireOptionalHasValue(
+		t,
+		uint64(math.MaxInt64),
+		optional.ToUint64Checked(optional.Some[int64](math.MaxInt64)),
+	)
+	requireNone(t, optional.ToUint64Checked(optional.Some[int64](-1)))
+	requireNone(t, optional.ToUint64Checked(optional.Some[int64](math.MinInt64)))
+}
+
+func TestDiv(t *testing.T) {
+	requireOptionalHasValue(t, 3, optional.Div(7, 2))
+	requireOptionalHasValue(t, -3, optional.Div(-7, 2))
+	requireOptionalHasValue(t, 0, optional.Div(0, 2))
+	requireNone(t, optional.Div(7, 0))
+	requireNone(t, optional.Div[uint8](7, 0))
+
+	requireOptionalHasValue(t, 3.5, optional.Div(7.0, 2.0))
+	requireNone(t, optional.Div(1.0, 0.0))
+	requireNone(t, optional.Div(-1.0, 0.0))
+	requireNone(t, optional.Div(1.0, math.Copysign(0, -1)))
+	requireNone(t, optional.Div(0.0, 0.0))
+
+	// Infinities can still be divided when the divisor is nonzero.
+	requireOptionalHasValue(t, math.Inf(1), optional.Div(math.Inf(1), 2))
+}
+
+func TestMod(t *testing.T) {
+	requireOptionalHasValue(t, 1, optional.Mod(7, 2))
+	requireOptionalHasValue(t, -1, optional.Mod(-7, 2))
+	requireOptionalHasValue(t, 0, optional.Mod(6, 3))
+	requireNone(t, optional.Mod(7, 0))
+	requireNone(t, optional.Mod[uint](7, 0))
+}
@@ -21,6 +21,16 @@
 // Package optional provides optional types and helpers.
 package optional
 
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+)
+
+// ErrNone indicates that an [Optional] holds no value.
+var ErrNone = errors.New("optional: no value held")
+
 // An Optional is a wrapper type that may or may not hold a value of type T.
 type Optional[T any] struct {
 	value T
@@ -40,6 +50,66 @@ func None[T any]() Optional[T] {
 	return Optional[T]{}
 }
 
+// Of produces an [Optional] that holds the value pointed to by p, or that
+// holds no value if p is nil.
+func Of[T any](p *T) Optional[T] {
+	if p == nil {
+		return None[T]()
+	}
+	return Some(*p)
+}
+
+// OfValue produces an [Optional] that holds the given value. It is an alias of
+// [Some].
+func OfValue[T any](value T) Optional[T] {
+	return Some(value)
+}
+
+// FromResult produces an [Optional] that holds value if err is nil, or that
+// holds no value otherwise. It is intended to wrap functions returning
+// (T, error) directly, e.g. FromResult(strconv.Atoi(s)).
+func FromResult[T any](value T, err error) Optional[T] {
+	if err != nil {
+		return None[T]()
+	}
+	return Some(value)
+}
+
+// FromVariadic produces an [Optional] that holds the first of values, or that
+// holds no value if values is empty. Any values after the first are ignored.
+// It is intended for collapsing a trailing variadic "optional" parameter.
+func FromVariadic[T any](values ...T) Optional[T] {
+	if len(values) == 0 {
+		return None[T]()
+	}
+	return Some(values[0])
+}
+
+// NoneIfErr produces an [Optional] that holds no value if err is not nil, or
+// that holds value otherwise. It is equivalent to [FromResult].
+func NoneIfErr[T any](value T, err error) Optional[T] {
+	return FromResult(value, err)
+}
+
+// SomeIf produces an [Optional] that holds value if cond is true, or that holds
+// no value otherwise.
+func SomeIf[T any](value T, cond bool) Optional[T] {
+	if !cond {
+		return None[T]()
+	}
+	return Some(value)
+}
+
+// SomeIfElse produces an [Optional] that holds the result of produce if cond is
+// true, or that holds no value otherwise. The given function is only evaluated
+// if cond is true.
+func SomeIfElse[T any](cond bool, produce func() T) Optional[T] {
+	if !cond {
+		return None[T]()
+	}
+	return Some(produce())
+}
+
 // Get returns a value of type T (either the held value, or the zero value of
 // T), and a boolean indicating if the value was held.
 func (o *Optional[T]) Get() (T, bool) {
@@ -51,10 +121,19 @@ func (o *Optional[T]) HasValue() bool {
 	return o.isset
 }
 
-// Value returns the held value of type T, or panics if no value is held.
+// Unwrap returns the held value of type T, or panics if no value is held.
+func (o *Optional[T]) Unwrap() T {
+	if !o.isset {
+		panic(noValueMessage[T]("Unwrap"))
+	}
+	return o.value
+}
+
+// Value returns the held value of type T, or panics if no value is held. It
+// behaves like [Optional.Unwrap], but its panic message names Value.
 func (o *Optional[T]) Value() T {
 	if !o.isset {
-		panic("Optional[%T].Value() called with no held value")
+		panic(noValueMessage[T]("Value"))
 	}
 	return o.value
 }
@@ -77,3 +156,291 @@ func (o *Optional[T]) ValueOrFunc(fallback func() T) T {
 	}
 	return fallback()
 }
+
+// GoString returns a Go-syntax representation of the [Optional], including
+// its type parameter, e.g. "optional.Some[int](42)" or "optional.None[int]()".
+// It is used when formatting with the %#v verb.
+func (o Optional[T]) GoString() string {
+	if o.isset {
+		return fmt.Sprintf("optional.Some[%s](%#v)", typeName[T](), o.value)
+	}
+	return fmt.Sprintf("optional.None[%s]()", typeName[T]())
+}
+
+// SwapOpt replaces the contents of the receiver with other, which may or may
+// not hold a value, and returns the previous contents. Swapping in a [None]
+// clears the receiver while retrieving what it held.
+func (o *Optional[T]) SwapOpt(other Optional[T]) Optional[T] {
+	prev := *o
+	*o = other
+	return prev
+}
+
+// UnwrapUnchecked returns the held value of type T without checking whether a
+// value is held. It is intended only for performance-critical paths where the
+// caller has already verified presence; if no value is held, it returns the
+// zero value of T rather than panicking. Prefer [Optional.Value] otherwise.
+func (o *Optional[T]) UnwrapUnchecked() T {
+	return o.value
+}
+
+// ValuePtr returns a pointer to the held value, or nil if no value is held.
+// The returned pointer aliases the storage of the [Optional], so writes
+// through it modify the held value in place.
+func (o *Optional[T]) ValuePtr() *T {
+	if !o.isset {
+		return nil
+	}
+	return &o.value
+}
+
+// OrPanic returns the held value of type T, or panics with err if no value is
+// held. Unlike a string message, err is used as the panic value as-is, so it
+// can be recovered and inspected as an error.
+func (o *Optional[T]) OrPanic(err error) T {
+	if !o.isset {
+		panic(err)
+	}
+	return o.value
+}
+
+// Ref returns a pointer to the held value, or nil if no value is held. If
+// mutable is true, the returned pointer aliases the storage of the [Optional]
+// (as with [Optional.ValuePtr]) and writes through it modify the held value.
+// If mutable is false, the returned pointer refers to a copy of the held
+// value, and writes through it do not affect the [Optional].
+func (o *Optional[T]) Ref(mutable bool) *T {
+	if !o.isset {
+		return nil
+	}
+
+	if mutable {
+		return &o.value
+	}
+
+	tmp := o.value
+	return &tmp
+}
+
+// OrZero returns the receiver if it holds a value, or an [Optional] holding the
+// zero value of T otherwise. Unlike [Optional.ValueOr], the result remains an
+// [Optional] for further chaining.
+func (o *Optional[T]) OrZero() Optional[T] {
+	if o.isset {
+		return *o
+	}
+
+	var zero T
+	return Some(zero)
+}
+
+// OrDefault returns the receiver if it holds a value, or an [Optional] holding
+// def otherwise.
+func (o *Optional[T]) OrDefault(def T) Optional[T] {
+	if o.isset {
+		return *o
+	}
+	return Some(def)
+}
+
+// IfSome calls fn with the held value if a value is held.
+func (o *Optional[T]) IfSome(fn func(T)) {
+	if o.isset {
+		fn(o.value)
+	}
+}
+
+// IfNone calls fn if no value is held.
+func (o *Optional[T]) IfNone(fn func()) {
+	if !o.isset {
+		fn()
+	}
+}
+
+// IfSomeElse calls some with the held value if a value is held, or none
+// otherwise. Exactly one of some or none is called.
+func (o *Optional[T]) IfSomeElse(some func(T), none func()) {
+	if o.isset {
+		some(o.value)
+	} else {
+		none()
+	}
+}
+
+// Validate returns nil if a value is held, or err otherwise.
+func (o *Optional[T]) Validate(err error) error {
+	if o.isset {
+		return nil
+	}
+	return err
+}
+
+// ValidateFunc returns nil if a value is held, or the result of fn otherwise.
+// The given function is only evaluated if no value is held.
+func (o *Optional[T]) ValidateFunc(fn func() error) error {
+	if o.isset {
+		return nil
+	}
+	return fn()
+}
+
+// SwapIf replaces the held value with value and returns the previous contents
+// if pred returns true for the held value. If pred returns false, the receiver
+// is left unchanged and SwapIf returns an [Optional] that holds no value. If
+// no value is held, value is always swapped in without calling pred, and the
+// (empty) previous contents are returned.
+func (o *Optional[T]) SwapIf(value T, pred func(T) bool) Optional[T] {
+	if o.isset && !pred(o.value) {
+		return None[T]()
+	}
+	return o.SwapOpt(Some(value))
+}
+
+// GetRef returns a pointer to the held value and true if a value is held, or
+// nil and false otherwise. The pointer aliases the storage of the [Optional]
+// and is intended for reading large values without copying them; callers must
+// treat it as read-only. Use [Optional.ValuePtr] to mutate in place.
+func (o *Optional[T]) GetRef() (*T, bool) {
+	if !o.isset {
+		return nil, false
+	}
+	return &o.value, true
+}
+
+// WithValue returns an [Optional] holding value, regardless of whether the
+// receiver holds a value. The receiver is not modified.
+func (o *Optional[T]) WithValue(value T) Optional[T] {
+	return Some(value)
+}
+
+// FilterSplit splits the receiver based on pred. If a value is held and pred
+// returns true for it, kept holds the value and rejected holds no value; if
+// pred returns false, rejected holds the value and kept holds no value. If no
+// value is held, both hold no value and pred is not called.
+func (o *Optional[T]) FilterSplit(pred func(T) bool) (kept Optional[T], rejected Optional[T]) {
+	switch {
+	case !o.isset:
+		return None[T](), None[T]()
+	case pred(o.value):
+		return *o, None[T]()
+	default:
+		return None[T](), *o
+	}
+}
+
+// View returns a value of type T (either the held value, or the zero value of
+// T), and a boolean indicating if the value was held. It is an alias of
+// [Optional.Get] and does not modify the receiver.
+func (o *Optional[T]) View() (value T, present bool) {
+	return o.Get()
+}
+
+// Peek calls fn with the held value if a value is held, and returns the
+// receiver for chaining.
+func (o *Optional[T]) Peek(fn func(T)) *Optional[T] {
+	o.IfSome(fn)
+	return o
+}
+
+// EnsureWith sets the receiver to hold the result of fn if no value is held,
+// and otherwise leaves the receiver unchanged. The given function is only
+// evaluated if no value is held.
+func (o *Optional[T]) EnsureWith(fn func() T) {
+	if !o.isset {
+		*o = Some(fn())
+	}
+}
+
+// ValueOrErr returns the held value of type T and a nil error, or the zero
+// value of T and [ErrNone] if no value is held.
+func (o *Optional[T]) ValueOrErr() (T, error) {
+	if !o.isset {
+		var zero T
+		return zero, ErrNone
+	}
+	return o.value, nil
+}
+
+// Require returns nil if a value is held, or an error wrapping [ErrNone] that
+// identifies the type of the [Optional] otherwise.
+func (o *Optional[T]) Require() error {
+	if o.isset {
+		return nil
+	}
+	return fmt.Errorf("%w: optional.Optional[%s]", ErrNone, typeName[T]())
+}
+
+// Tee assigns the held value to *dst, if a value is held, and returns the
+// receiver's contents. If no value is held, dst is not used (and may be nil);
+// if a value is held and dst is nil, Tee panics.
+func (o *Optional[T]) Tee(dst *T) Optional[T] {
+	if o.isset {
+		if dst == nil {
+			panic(fmt.Sprintf(
+				"optional.Optional[%s].Tee() called with a nil destination",
+				typeName[T](),
+			))
+		}
+		*dst = o.value
+	}
+	return *o
+}
+
+// MustValue returns the held value of type T, or panics if no value is held.
+// The panic message includes the given context and the file and line of the
+// call to MustValue, to identify where an unexpectedly empty [Optional] was
+// encountered.
+func (o *Optional[T]) MustValue(context string) T {
+	if !o.isset {
+		_, file, line, _ := runtime.Caller(1)
+		panic(fmt.Sprintf(
+			"%s: %s (at %s:%d)",
+			noValueMessage[T]("MustValue"),
+			context,
+			file,
+			line,
+		))
+	}
+	return o.value
+}
+
+// MapInPlace replaces the held value with the result of calling fn with it, if
+// a value is held. The given function is only evaluated if a value is held.
+func (o *Optional[T]) MapInPlace(fn func(T) T) {
+	if o.isset {
+		o.value = fn(o.value)
+	}
+}
+
+// Len returns 1 if a value is held, or 0 otherwise, allowing an [Optional] to
+// be treated as a container of at most one value.
+func (o *Optional[T]) Len() int {
+	if o.isset {
+		return 1
+	}
+	return 0
+}
+
+// Type returns the [reflect.Type] of T, regardless of whether a value is held.
+// Unlike calling [reflect.TypeOf] with a zero value, this also reports
+// interface types (such as error) rather than nil.
+func (o *Optional[T]) Type() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+// typeName returns the name of T for use in messages. Unlike formatting a zero
+// T with %T, it also names interface types (such as error) rather than
+// producing "<nil>".
+func typeName[T any]() string {
+	return reflect.TypeFor[T]().String()
+}
+
+// noValueMessage returns the panic message used when the given method is
+// called on an [Optional] that holds no value.
+func noValueMessage[T any](method string) string {
+	return fmt.Sprintf(
+		"optional.Optional[%s].%s() called with no held value",
+		typeName[T](),
+		method,
+	)
+}
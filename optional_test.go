@@ -21,6 +21,10 @@
 package optional_test
 
 import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -42,6 +46,11 @@ func requireOptionalHasValue[T any](
 	})
 }
 
+func requireNone[T any](t *testing.T, maybe optional.Optional[T]) {
+	t.Helper()
+	require.False(t, maybe.HasValue())
+}
+
 func TestSome(t *testing.T) {
 	requireOptionalHasValue(t, true, optional.Some(true))
 	requireOptionalHasValue(t, false, optional.Some(false))
@@ -67,6 +76,77 @@ func TestNone(t *testing.T) {
 	})
 }
 
+func TestOf(t *testing.T) {
+	opt := optional.Of[int](nil)
+	require.False(t, opt.HasValue())
+	require.Equal(t, optional.None[int](), opt)
+
+	x := 123
+	opt = optional.Of(&x)
+	requireOptionalHasValue(t, 123, opt)
+	require.Equal(t, optional.Some(123), opt)
+
+	x = 234
+	requireOptionalHasValue(t, 123, opt)
+}
+
+func TestOfValue(t *testing.T) {
+	require.Equal(t, optional.Some(123), optional.OfValue(123))
+	require.Equal(t, optional.Some(0), optional.OfValue(0))
+	require.Equal(t, optional.Some((*int)(nil)), optional.OfValue((*int)(nil)))
+}
+
+func TestFromResult(t *testing.T) {
+	requireOptionalHasValue(t, 123, optional.FromResult(strconv.Atoi("123")))
+	requireOptionalHasValue(t, 0, optional.FromResult(0, nil))
+
+	opt := optional.FromResult(strconv.Atoi("abc"))
+	require.False(t, opt.HasValue())
+
+	opt = optional.FromResult(123, errors.New("oops"))
+	require.False(t, opt.HasValue())
+}
+
+func TestFromVariadic(t *testing.T) {
+	opt := optional.FromVariadic[int]()
+	require.False(t, opt.HasValue())
+
+	requireOptionalHasValue(t, 0, optional.FromVariadic(0))
+	requireOptionalHasValue(t, 123, optional.FromVariadic(123))
+	requireOptionalHasValue(t, 123, optional.FromVariadic(123, 234, 345))
+}
+
+func TestNoneIfErr(t *testing.T) {
+	requireOptionalHasValue(t, 123, optional.NoneIfErr(123, nil))
+	requireOptionalHasValue(t, 0, optional.NoneIfErr(strconv.Atoi("0")))
+
+	opt := optional.NoneIfErr(123, errors.New("oops"))
+	require.False(t, opt.HasValue())
+}
+
+func TestSomeIf(t *testing.T) {
+	requireOptionalHasValue(t, 123, optional.SomeIf(123, true))
+	requireOptionalHasValue(t, 0, optional.SomeIf(0, true))
+
+	opt := optional.SomeIf(123, false)
+	require.False(t, opt.HasValue())
+}
+
+func TestSomeIfElse(t *testing.T) {
+	var calls int
+	produce := func() int {
+		calls++
+		return 123
+	}
+
+	opt := optional.SomeIfElse(false, produce)
+	require.False(t, opt.HasValue())
+	require.Equal(t, 0, calls)
+
+	requireOptionalHasValue(t, 123, optional.SomeIfElse(true, produce))
+	require.Equal(t, 1, calls)
+}
+
 func TestOptional_HasValue(t *testing.T) {
 	var opt optional.Optional[bool]
 	require.False(t, opt.HasValue())
@@ -90,6 +170,38 @@ func TestOptional_Value(t *testing.T) {
 	})
 }
 
+func TestOptional_Unwrap(t *testing.T) {
+	var opt optional.Optional[int]
+	require.PanicsWithValue(
+		t,
+		"optional.Optional[int].Unwrap() called with no held value",
+		func() { opt.Unwrap() },
+	)
+	require.PanicsWithValue(
+		t,
+		"optional.Optional[int].Value() called with no held value",
+		func() { opt.Value() },
+	)
+
+	var errOpt optional.Optional[error]
+	require.PanicsWithValue(
+		t,
+		"optional.Optional[error].Unwrap() called with no held value",
+		func() { errOpt.Unwrap() },
+	)
+	require.PanicsWithValue(
+		t,
+		"optional.Optional[error].Value() called with no held value",
+		func() { errOpt.Value() },
+	)
+
+	opt = optional.Some(123)
+	require.NotPanics(t, func() {
+		require.Equal(t, 123, opt.Unwrap())
+		require.Equal(t, opt.Value(), opt.Unwrap())
+	})
+}
+
 func TestOptional_Get(t *testing.T) {
 	var opt optional.Optional[bool]
 
@@ -129,3 +241,563 @@ func TestOptional_ValueOrFunc(t *testing.T) {
 	opt = optional.Some(345)
 	require.Equal(t, 345, opt.ValueOrFunc(func() int { return -1 }))
 }
+
+func TestOptional_GoString(t *testing.T) {
+	require.Equal(t, "optional.Some[int](42)", fmt.Sprintf("%#v", optional.Some(42)))
+	require.Equal(t, `optional.Some[string]("x")`, fmt.Sprintf("%#v", optional.Some("x")))
+	require.Equal(t, "optional.None[int]()", fmt.Sprintf("%#v", optional.None[int]()))
+
+	var opt optional.Optional[bool]
+	require.Equal(t, "optional.None[bool]()", fmt.Sprintf("%#v", opt))
+	require.Equal(t, "optional.None[bool]()", fmt.Sprintf("%#v", &opt))
+
+	require.Equal(t, "optional.None[error]()", fmt.Sprintf("%#v", optional.None[error]()))
+}
+
+func TestOptional_SwapOpt(t *testing.T) {
+	var opt optional.Optional[int]
+
+	prev := opt.SwapOpt(optional.None[int]())
+	require.False(t, prev.HasValue())
+	require.False(t, opt.HasValue())
+
+	prev = opt.SwapOpt(optional.Some(123))
+	require.False(t, prev.HasValue())
+	requireOptionalHasValue(t, 123, opt)
+
+	prev = opt.SwapOpt(optional.Some(234))
+	requireOptionalHasValue(t, 123, prev)
+	requireOptionalHasValue(t, 234, opt)
+
+	prev = opt.SwapOpt(optional.None[int]())
+	requireOptionalHasValue(t, 234, prev)
+	require.False(t, opt.HasValue())
+}
+
+func TestOptional_UnwrapUnchecked(t *testing.T) {
+	opt := optional.Some(123)
+	require.Equal(t, 123, opt.UnwrapUnchecked())
+
+	opt = optional.None[int]()
+	require.Zero(t, opt.UnwrapUnchecked())
+}
+
+func BenchmarkOptional_Value(b *testing.B) {
+	var (
+		opt  = optional.Some(123)
+		sink int
+	)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		sink = opt.Value()
+	}
+
+	_ = sink
+}
+
+func BenchmarkOptional_UnwrapUnchecked(b *testing.B) {
+	var (
+		opt  = optional.Some(123)
+		sink int
+	)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		sink = opt.UnwrapUnchecked()
+	}
+
+	_ = sink
+}
+
+type benchLargeValue struct {
+	data [64]int64
+}
+
+func BenchmarkOptional_ValueOr(b *testing.B) {
+	var (
+		some     = optional.Some(benchLargeValue{})
+		none     = optional.None[benchLargeValue]()
+		fallback benchLargeValue
+		sink     benchLargeValue
+	)
+
+	b.Run("some", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sink = some.ValueOr(fallback)
+		}
+	})
+
+	b.Run("none", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sink = none.ValueOr(fallback)
+		}
+	})
+
+	_ = sink
+}
+
+func BenchmarkOptional_ValueOrFunc(b *testing.B) {
+	var (
+		some     = optional.Some(benchLargeValue{})
+		none     = optional.None[benchLargeValue]()
+		fallback = func() benchLargeValue { return benchLargeValue{} }
+		sink     benchLargeValue
+	)
+
+	b.Run("some", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sink = some.ValueOrFunc(fallback)
+		}
+	})
+
+	b.Run("none", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sink = none.ValueOrFunc(fallback)
+		}
+	})
+
+	_ = sink
+}
+
+func BenchmarkOptional_Get(b *testing.B) {
+	var (
+		opt  = optional.Some(benchLargeValue{})
+		sink benchLargeValue
+		ok   bool
+	)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sink, ok = opt.Get()
+	}
+
+	_, _ = sink, ok
+}
+
+func TestOptional_ValuePtr(t *testing.T) {
+	type point struct {
+		X int
+		Y int
+	}
+
+	var opt optional.Optional[point]
+	require.Nil(t, opt.ValuePtr())
+
+	opt = optional.Some(point{X: 1, Y: 2})
+	ptr := opt.ValuePtr()
+	require.NotNil(t, ptr)
+
+	ptr.X = 123
+	require.Equal(t, point{X: 123, Y: 2}, opt.Value())
+}
+
+func TestOptional_OrPanic(t *testing.T) {
+	errMissing := errors.New("missing")
+
+	opt := optional.Some(123)
+	require.NotPanics(t, func() {
+		require.Equal(t, 123, opt.OrPanic(errMissing))
+	})
+
+	opt = optional.None[int]()
+	require.PanicsWithError(t, errMissing.Error(), func() {
+		opt.OrPanic(errMissing)
+	})
+
+	defer func() {
+		require.Equal(t, errMissing, recover())
+	}()
+	opt.OrPanic(errMissing)
+}
+
+func TestOptional_Ref(t *testing.T) {
+	var opt optional.Optional[int]
+	require.Nil(t, opt.Ref(true))
+	require.Nil(t, opt.Ref(false))
+
+	opt = optional.Some(123)
+
+	ptr := opt.Ref(false)
+	require.NotNil(t, ptr)
+	require.Equal(t, 123, *ptr)
+	*ptr = 234
+	require.Equal(t, 123, opt.Value())
+
+	ptr = opt.Ref(true)
+	require.NotNil(t, ptr)
+	require.Equal(t, 123, *ptr)
+	*ptr = 345
+	require.Equal(t, 345, opt.Value())
+}
+
+func TestOptional_OrZero(t *testing.T) {
+	var opt optional.Optional[int]
+	requireOptionalHasValue(t, 0, opt.OrZero())
+
+	opt = optional.Some(123)
+	requireOptionalHasValue(t, 123, opt.OrZero())
+}
+
+func TestOptional_OrDefault(t *testing.T) {
+	var opt optional.Optional[int]
+	requireOptionalHasValue(t, 234, opt.OrDefault(234))
+	require.False(t, opt.HasValue())
+
+	opt = optional.Some(123)
+	requireOptionalHasValue(t, 123, opt.OrDefault(234))
+}
+
+func TestOptional_IfSome(t *testing.T) {
+	var calls []int
+	fn := func(x int) {
+		calls = append(calls, x)
+	}
+
+	opt := optional.None[int]()
+	opt.IfSome(fn)
+	require.Empty(t, calls)
+
+	opt = optional.Some(123)
+	opt.IfSome(fn)
+	require.Equal(t, []int{123}, calls)
+}
+
+func TestOptional_IfNone(t *testing.T) {
+	var calls int
+	fn := func() {
+		calls++
+	}
+
+	opt := optional.Some(123)
+	opt.IfNone(fn)
+	require.Equal(t, 0, calls)
+
+	opt = optional.None[int]()
+	opt.IfNone(fn)
+	require.Equal(t, 1, calls)
+}
+
+func TestOptional_IfSomeElse(t *testing.T) {
+	var (
+		someCalls []int
+		noneCalls int
+		some      = func(x int) { someCalls = append(someCalls, x) }
+		none      = func() { noneCalls++ }
+	)
+
+	opt := optional.Some(123)
+	opt.IfSomeElse(some, none)
+	require.Equal(t, []int{123}, someCalls)
+	require.Equal(t, 0, noneCalls)
+
+	opt = optional.None[int]()
+	opt.IfSomeElse(some, none)
+	require.Equal(t, []int{123}, someCalls)
+	require.Equal(t, 1, noneCalls)
+}
+
+func TestOptional_Validate(t *testing.T) {
+	errMissing := errors.New("missing")
+
+	opt := optional.Some(123)
+	require.NoError(t, opt.Validate(errMissing))
+
+	opt = optional.None[int]()
+	require.ErrorIs(t, opt.Validate(errMissing), errMissing)
+}
+
+func TestOptional_ValidateFunc(t *testing.T) {
+	var (
+		errMissing = errors.New("missing")
+		calls      int
+		fn         = func() error {
+			calls++
+			return errMissing
+		}
+	)
+
+	opt := optional.Some(123)
+	require.NoError(t, opt.ValidateFunc(fn))
+	require.Equal(t, 0, calls)
+
+	opt = optional.None[int]()
+	require.ErrorIs(t, opt.ValidateFunc(fn), errMissing)
+	require.Equal(t, 1, calls)
+}
+
+func TestOptional_SwapIf(t *testing.T) {
+	var (
+		calls []int
+		stale = func(x int) bool {
+			calls = append(calls, x)
+			return x < 100
+		}
+	)
+
+	var opt optional.Optional[int]
+	prev := opt.SwapIf(1, stale)
+	require.False(t, prev.HasValue())
+	requireOptionalHasValue(t, 1, opt)
+	require.Empty(t, calls)
+
+	prev = opt.SwapIf(123, stale)
+	requireOptionalHasValue(t, 1, prev)
+	requireOptionalHasValue(t, 123, opt)
+	require.Equal(t, []int{1}, calls)
+
+	prev = opt.SwapIf(234, stale)
+	require.False(t, prev.HasValue())
+	requireOptionalHasValue(t, 123, opt)
+	require.Equal(t, []int{1, 123}, calls)
+}
+
+func TestOptional_GetRef(t *testing.T) {
+	var opt optional.Optional[benchLargeValue]
+	ref, ok := opt.GetRef()
+	require.False(t, ok)
+	require.Nil(t, ref)
+
+	var value benchLargeValue
+	value.data[10] = 123
+	opt = optional.Some(value)
+
+	ref, ok = opt.GetRef()
+	require.True(t, ok)
+	require.NotNil(t, ref)
+	require.Equal(t, int64(123), ref.data[10])
+	require.Same(t, ref, opt.ValuePtr())
+}
+
+func TestOptional_WithValue(t *testing.T) {
+	var opt optional.Optional[int]
+	requireOptionalHasValue(t, 123, opt.WithValue(123))
+	require.False(t, opt.HasValue())
+
+	opt = opt.WithValue(234)
+	requireOptionalHasValue(t, 234, opt)
+
+	opt = opt.WithValue(345)
+	requireOptionalHasValue(t, 345, opt)
+}
+
+func TestOptional_FilterSplit(t *testing.T) {
+	var (
+		calls int
+		even  = func(x int) bool {
+			calls++
+			return x%2 == 0
+		}
+	)
+
+	opt := optional.Some(2)
+	kept, rejected := opt.FilterSplit(even)
+	requireOptionalHasValue(t, 2, kept)
+	require.False(t, rejected.HasValue())
+	require.Equal(t, 1, calls)
+
+	opt = optional.Some(3)
+	kept, rejected = opt.FilterSplit(even)
+	require.False(t, kept.HasValue())
+	requireOptionalHasValue(t, 3, rejected)
+	require.Equal(t, 2, calls)
+
+	opt = optional.None[int]()
+	kept, rejected = opt.FilterSplit(even)
+	require.False(t, kept.HasValue())
+	require.False(t, rejected.HasValue())
+	require.Equal(t, 2, calls)
+}
+
+func TestOptional_View(t *testing.T) {
+	var opt optional.Optional[int]
+	value, ok := opt.View()
+	require.False(t, ok)
+	require.Zero(t, value)
+
+	opt = optional.Some(123)
+	value, ok = opt.View()
+	require.True(t, ok)
+	require.Equal(t, 123, value)
+	requireOptionalHasValue(t, 123, opt)
+}
+
+func TestOptional_Peek(t *testing.T) {
+	var calls []int
+	fn := func(x int) {
+		calls = append(calls, x)
+	}
+
+	opt := optional.None[int]()
+	require.Same(t, &opt, opt.Peek(fn).Peek(fn))
+	require.Empty(t, calls)
+
+	opt = optional.Some(123)
+	require.Same(t, &opt, opt.Peek(fn).Peek(fn))
+	require.Equal(t, []int{123, 123}, calls)
+	require.Equal(t, 123, opt.Peek(fn).Value())
+}
+
+func TestOptional_EnsureWith(t *testing.T) {
+	var calls int
+	fn := func() int {
+		calls++
+		return 123
+	}
+
+	var opt optional.Optional[int]
+	opt.EnsureWith(fn)
+	requireOptionalHasValue(t, 123, opt)
+	require.Equal(t, 1, calls)
+
+	opt.EnsureWith(fn)
+	requireOptionalHasValue(t, 123, opt)
+	require.Equal(t, 1, calls)
+
+	opt = optional.Some(234)
+	opt.EnsureWith(fn)
+	requireOptionalHasValue(t, 234, opt)
+	require.Equal(t, 1, calls)
+}
+
+func TestOptional_ValueOrErr(t *testing.T) {
+	opt := optional.Some(123)
+	value, err := opt.ValueOrErr()
+	require.NoError(t, err)
+	require.Equal(t, 123, value)
+
+	opt = optional.None[int]()
+	value, err = opt.ValueOrErr()
+	require.ErrorIs(t, err, optional.ErrNone)
+	require.Zero(t, value)
+}
+
+func TestOptional_Require(t *testing.T) {
+	opt := optional.Some(123)
+	require.NoError(t, opt.Require())
+
+	opt = optional.None[int]()
+	err := opt.Require()
+	require.ErrorIs(t, err, optional.ErrNone)
+	require.Contains(t, err.Error(), "optional.Optional[int]")
+
+	sopt := optional.None[string]()
+	require.Contains(t, sopt.Require().Error(), "optional.Optional[string]")
+
+	eopt := optional.None[error]()
+	require.Contains(t, eopt.Require().Error(), "optional.Optional[error]")
+}
+
+func TestOptional_Tee(t *testing.T) {
+	dst := -1
+
+	opt := optional.None[int]()
+	res := opt.Tee(&dst)
+	require.False(t, res.HasValue())
+	require.Equal(t, -1, dst)
+	require.NotPanics(t, func() {
+		opt.Tee(nil)
+	})
+
+	opt = optional.Some(123)
+	res = opt.Tee(&dst)
+	requireOptionalHasValue(t, 123, res)
+	require.Equal(t, 123, dst)
+	require.PanicsWithValue(
+		t,
+		"optional.Optional[int].Tee() called with a nil destination",
+		func() { opt.Tee(nil) },
+	)
+}
+
+func TestOptional_MustValue(t *testing.T) {
+	opt := optional.Some(123)
+	require.NotPanics(t, func() {
+		require.Equal(t, 123, opt.MustValue("loading config"))
+	})
+
+	opt = optional.None[int]()
+
+	var msg string
+	func() {
+		defer func() {
+			var ok bool
+			msg, ok = recover().(string)
+			require.True(t, ok)
+		}()
+		opt.MustValue("loading config")
+	}()
+
+	require.Contains(t, msg, "optional.Optional[int]")
+	require.Contains(t, msg, "loading config")
+	require.Regexp(t, `optional_test\.go:\d+\)$`, msg)
+
+	var errOpt optional.Optional[error]
+	func() {
+		defer func() {
+			var ok bool
+			msg, ok = recover().(string)
+			require.True(t, ok)
+		}()
+		errOpt.MustValue("loading config")
+	}()
+
+	require.Contains(t, msg, "optional.Optional[error].MustValue()")
+}
+
+func TestOptional_MapInPlace(t *testing.T) {
+	var calls int
+	double := func(x int) int {
+		calls++
+		return x * 2
+	}
+
+	opt := optional.None[int]()
+	opt.MapInPlace(double)
+	require.False(t, opt.HasValue())
+	require.Equal(t, 0, calls)
+
+	opt = optional.Some(123)
+	opt.MapInPlace(double)
+	requireOptionalHasValue(t, 246, opt)
+	require.Equal(t, 1, calls)
+}
+
+func TestOptional_Len(t *testing.T) {
+	var opt optional.Optional[int]
+	require.Equal(t, 0, opt.Len())
+
+	opt = optional.Some(0)
+	require.Equal(t, 1, opt.Len())
+
+	var sized interface{ Len() int } = &opt
+	require.Equal(t, 1, sized.Len())
+}
+
+func TestOptional_Type(t *testing.T) {
+	var (
+		intZero    int
+		structZero benchLargeValue
+	)
+
+	opt := optional.None[int]()
+	require.Equal(t, reflect.TypeOf(intZero), opt.Type())
+
+	opt = optional.Some(123)
+	require.Equal(t, reflect.TypeOf(intZero), opt.Type())
+
+	sopt := optional.None[benchLargeValue]()
+	require.Equal(t, reflect.TypeOf(structZero), sopt.Type())
+
+	eopt := optional.None[error]()
+	require.Equal(t, reflect.TypeFor[error](), eopt.Type())
+	require.NotNil(t, eopt.Type())
+}
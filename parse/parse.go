@@ -0,0 +1,58 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+// Package parse provides parsing helpers that produce optional values.
+package parse
+
+import (
+	"time"
+
+	"go.mway.dev/optional"
+)
+
+// ParseDuration parses s as a [time.Duration] (see [time.ParseDuration]),
+// returning an [optional.Optional] that holds no value if s is empty or cannot
+// be parsed.
+func ParseDuration(s string) optional.Optional[time.Duration] {
+	if s == "" {
+		return optional.None[time.Duration]()
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return optional.None[time.Duration]()
+	}
+	return optional.Some(d)
+}
+
+// ParseTime parses s as a [time.Time] using layout (see [time.Parse]),
+// returning an [optional.Optional] that holds no value if s is empty or cannot
+// be parsed.
+func ParseTime(layout string, s string) optional.Optional[time.Time] {
+	if s == "" {
+		return optional.None[time.Time]()
+	}
+
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		return optional.None[time.Time]()
+	}
+	return optional.Some(t)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package parse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional/parse"
+)
+
+func TestParseDuration(t *testing.T) {
+	opt := parse.ParseDuration("1m30s")
+	have, ok := opt.Get()
+	require.True(t, ok)
+	require.Equal(t, 90*time.Second, have)
+
+	opt = parse.ParseDuration("0s")
+	have, ok = opt.Get()
+	require.True(t, ok)
+	require.Zero(t, have)
+
+	opt = parse.ParseDuration("invalid")
+	require.False(t, opt.HasValue())
+
+	opt = parse.ParseDuration("")
+	require.False(t, opt.HasValue())
+}
+
+func TestParseTime(t *testing.T) {
+	opt := parse.ParseTime(time.DateOnly, "2024-01-02")
+	have, ok := opt.Get()
+	require.True(t, ok)
+	require.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), have)
+
+	opt = parse.ParseTime(time.DateOnly, "invalid")
+	require.False(t, opt.HasValue())
+
+	opt = parse.ParseTime(time.DateOnly, "")
+	require.False(t, opt.HasValue())
+}
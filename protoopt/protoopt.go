@@ -0,0 +1,63 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+// Package protoopt provides conversions between optional types and protobuf
+// well-known wrapper types (such as [wrapperspb.StringValue]).
+package protoopt
+
+import (
+	"go.mway.dev/optional"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// A Wrapper is a protobuf well-known wrapper type holding a value of type T.
+type Wrapper[T any] interface {
+	*wrapperspb.StringValue |
+		*wrapperspb.BytesValue |
+		*wrapperspb.BoolValue |
+		*wrapperspb.Int32Value |
+		*wrapperspb.Int64Value |
+		*wrapperspb.UInt32Value |
+		*wrapperspb.UInt64Value |
+		*wrapperspb.FloatValue |
+		*wrapperspb.DoubleValue
+
+	GetValue() T
+}
+
+// FromWrapper converts w to an [optional.Optional]. A nil w produces an
+// [optional.Optional] that holds no value.
+func FromWrapper[T any, W Wrapper[T]](w W) optional.Optional[T] {
+	if w == nil {
+		return optional.None[T]()
+	}
+	return optional.Some(w.GetValue())
+}
+
+// ToWrapper converts o to a wrapper using wrap, which is typically one of the
+// [wrapperspb] constructors (e.g. [wrapperspb.String]). If o holds no value,
+// ToWrapper returns nil without calling wrap.
+func ToWrapper[T any, W Wrapper[T]](o optional.Optional[T], wrap func(T) W) W {
+	value, ok := o.Get()
+	if !ok {
+		return nil
+	}
+	return wrap(value)
+}
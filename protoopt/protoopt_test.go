@@ -0,0 +1,91 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package protoopt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+	"go.mway.dev/optional/protoopt"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func requireWrapperRoundTrip[T any, W protoopt.Wrapper[T]](
+	t *testing.T,
+	value T,
+	wrap func(T) W,
+) {
+	t.Helper()
+
+	none := protoopt.FromWrapper[T, W](nil)
+	require.False(t, none.HasValue())
+	require.Nil(t, protoopt.ToWrapper(optional.None[T](), wrap))
+
+	wrapper := protoopt.ToWrapper(optional.Some(value), wrap)
+	require.NotNil(t, wrapper)
+	require.Equal(t, value, wrapper.GetValue())
+
+	opt := protoopt.FromWrapper(wrapper)
+	have, ok := opt.Get()
+	require.True(t, ok)
+	require.Equal(t, value, have)
+}
+
+func TestWrappers(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		requireWrapperRoundTrip(t, "foo", wrapperspb.String)
+		requireWrapperRoundTrip(t, "", wrapperspb.String)
+	})
+
+	t.Run("bytes", func(t *testing.T) {
+		requireWrapperRoundTrip(t, []byte("foo"), wrapperspb.Bytes)
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		requireWrapperRoundTrip(t, true, wrapperspb.Bool)
+		requireWrapperRoundTrip(t, false, wrapperspb.Bool)
+	})
+
+	t.Run("int32", func(t *testing.T) {
+		requireWrapperRoundTrip(t, int32(-123), wrapperspb.Int32)
+	})
+
+	t.Run("int64", func(t *testing.T) {
+		requireWrapperRoundTrip(t, int64(-123), wrapperspb.Int64)
+	})
+
+	t.Run("uint32", func(t *testing.T) {
+		requireWrapperRoundTrip(t, uint32(123), wrapperspb.UInt32)
+	})
+
+	t.Run("uint64", func(t *testing.T) {
+		requireWrapperRoundTrip(t, uint64(123), wrapperspb.UInt64)
+	})
+
+	t.Run("float", func(t *testing.T) {
+		requireWrapperRoundTrip(t, float32(1.5), wrapperspb.Float)
+	})
+
+	t.Run("double", func(t *testing.T) {
+		requireWrapperRoundTrip(t, 1.5, wrapperspb.Double)
+	})
+}
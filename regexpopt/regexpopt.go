@@ -0,0 +1,49 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+// Package regexpopt provides [regexp] helpers that produce optional values.
+package regexpopt
+
+import (
+	"regexp"
+
+	"go.mway.dev/optional"
+)
+
+// SubmatchOptional returns an [optional.Optional] holding the text captured by
+// the given group of re in the leftmost match of s. If re does not match s,
+// group is out of range, or the group did not participate in the match, the
+// result holds no value. A group that participated but matched the empty
+// string produces Some("").
+func SubmatchOptional(
+	re *regexp.Regexp,
+	s string,
+	group int,
+) optional.Optional[string] {
+	if group < 0 || group > re.NumSubexp() {
+		return optional.None[string]()
+	}
+
+	loc := re.FindStringSubmatchIndex(s)
+	if loc == nil || loc[2*group] < 0 {
+		return optional.None[string]()
+	}
+	return optional.Some(s[loc[2*group]:loc[2*group+1]])
+}
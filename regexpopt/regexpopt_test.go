@@ -0,0 +1,94 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package regexpopt_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+	"go.mway.dev/optional/regexpopt"
+)
+
+func TestSubmatchOptional(t *testing.T) {
+	re := regexp.MustCompile(`level=(\w+)(?: user=(\w*))?`)
+
+	cases := []struct {
+		name  string
+		input string
+		group int
+		want  optional.Optional[string]
+	}{
+		{
+			name:  "match",
+			input: "level=info user=foo",
+			group: 2,
+			want:  optional.Some("foo"),
+		},
+		{
+			name:  "whole match",
+			input: "level=info user=foo",
+			group: 0,
+			want:  optional.Some("level=info user=foo"),
+		},
+		{
+			name:  "matched empty group",
+			input: "level=info user=",
+			group: 2,
+			want:  optional.Some(""),
+		},
+		{
+			name:  "optional group absent",
+			input: "level=info",
+			group: 2,
+			want:  optional.None[string](),
+		},
+		{
+			name:  "no match",
+			input: "nothing here",
+			group: 1,
+			want:  optional.None[string](),
+		},
+		{
+			name:  "group out of range",
+			input: "level=info user=foo",
+			group: 3,
+			want:  optional.None[string](),
+		},
+		{
+			name:  "negative group",
+			input: "level=info user=foo",
+			group: -1,
+			want:  optional.None[string](),
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				tt.want,
+				regexpopt.SubmatchOptional(re, tt.input, tt.group),
+			)
+		})
+	}
+}
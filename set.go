@@ -0,0 +1,64 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional
+
+// A Set is a collection of unique [Optional] values. [None] is treated as a
+// single, distinct member, separate from any [Some] (including one holding the
+// zero value of T). The zero value of a Set is empty and ready to use.
+type Set[T comparable] struct {
+	members map[Optional[T]]struct{}
+}
+
+// NewSet produces a [Set] containing the given optionals.
+func NewSet[T comparable](opts ...Optional[T]) *Set[T] {
+	s := &Set[T]{
+		members: make(map[Optional[T]]struct{}, len(opts)),
+	}
+	for _, opt := range opts {
+		s.Add(opt)
+	}
+	return s
+}
+
+// Add adds opt to the set, returning true if it was not already a member.
+func (s *Set[T]) Add(opt Optional[T]) bool {
+	if s.members == nil {
+		s.members = make(map[Optional[T]]struct{})
+	}
+
+	if _, ok := s.members[opt]; ok {
+		return false
+	}
+
+	s.members[opt] = struct{}{}
+	return true
+}
+
+// Contains indicates whether opt is a member of the set.
+func (s *Set[T]) Contains(opt Optional[T]) bool {
+	_, ok := s.members[opt]
+	return ok
+}
+
+// Len returns the number of members in the set.
+func (s *Set[T]) Len() int {
+	return len(s.members)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+)
+
+func TestNewSet(t *testing.T) {
+	set := optional.NewSet(
+		optional.Some(1),
+		optional.None[int](),
+		optional.Some(1),
+		optional.None[int](),
+	)
+	require.Equal(t, 2, set.Len())
+	require.True(t, set.Contains(optional.Some(1)))
+	require.True(t, set.Contains(optional.None[int]()))
+	require.False(t, set.Contains(optional.Some(2)))
+}
+
+func TestSet_Add(t *testing.T) {
+	var set optional.Set[int]
+	require.Equal(t, 0, set.Len())
+	require.False(t, set.Contains(optional.None[int]()))
+
+	require.True(t, set.Add(optional.None[int]()))
+	require.False(t, set.Add(optional.None[int]()))
+	require.Equal(t, 1, set.Len())
+
+	require.True(t, set.Add(optional.Some(0)))
+	require.False(t, set.Add(optional.Some(0)))
+	require.Equal(t, 2, set.Len())
+
+	require.True(t, set.Add(optional.Some(123)))
+	require.Equal(t, 3, set.Len())
+
+	require.True(t, set.Contains(optional.None[int]()))
+	require.True(t, set.Contains(optional.Some(0)))
+	require.True(t, set.Contains(optional.Some(123)))
+	require.False(t, set.Contains(optional.Some(234)))
+}
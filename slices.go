@@ -0,0 +1,196 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional
+
+import (
+	"cmp"
+	"slices"
+)
+
+// ReduceOr reduces the values held by opts pairwise using combine, in order,
+// and returns an [Optional] holding the result. Optionals that hold no value
+// are skipped; if none of opts hold a value, ReduceOr returns an [Optional]
+// that holds no value. combine is called once fewer than the number of held
+// values.
+func ReduceOr[T any](opts []Optional[T], combine func(T, T) T) Optional[T] {
+	var acc Optional[T]
+	for _, opt := range opts {
+		switch {
+		case !opt.isset:
+			continue
+		case !acc.isset:
+			acc = opt
+		default:
+			acc.value = combine(acc.value, opt.value)
+		}
+	}
+	return acc
+}
+
+// SomeEach produces a slice of optionals, each holding the corresponding
+// element of values.
+func SomeEach[T any](values []T) []Optional[T] {
+	opts := make([]Optional[T], len(values))
+	for i, value := range values {
+		opts[i] = Some(value)
+	}
+	return opts
+}
+
+// NoneSlice produces a slice of n optionals that hold no value.
+func NoneSlice[T any](n int) []Optional[T] {
+	return make([]Optional[T], n)
+}
+
+// ForEachSome calls fn, in order, with each value held by opts. Optionals that
+// hold no value are skipped.
+func ForEachSome[T any](opts []Optional[T], fn func(T)) {
+	for _, opt := range opts {
+		if opt.isset {
+			fn(opt.value)
+		}
+	}
+}
+
+// ValuesOr returns the values held by opts, in order, with fallback in place of
+// each [Optional] that holds no value. The result has the same length as opts.
+func ValuesOr[T any](opts []Optional[T], fallback T) []T {
+	values := make([]T, len(opts))
+	for i, opt := range opts {
+		values[i] = opt.ValueOr(fallback)
+	}
+	return values
+}
+
+// ValuesOrFunc returns the values held by opts, in order, with fn(i) in place
+// of each [Optional] at index i that holds no value. The result has the same
+// length as opts, and fn is only called for indexes that hold no value.
+func ValuesOrFunc[T any](opts []Optional[T], fn func(i int) T) []T {
+	values := make([]T, len(opts))
+	for i, opt := range opts {
+		if opt.isset {
+			values[i] = opt.value
+		} else {
+			values[i] = fn(i)
+		}
+	}
+	return values
+}
+
+// MapIndexed maps each value held by opts to a value of type Out using fn,
+// which is called with the index and the value. Optionals that hold no value
+// remain empty in the result, and fn is not called for them.
+func MapIndexed[In any, Out any](
+	opts []Optional[In],
+	fn func(i int, v In) Out,
+) []Optional[Out] {
+	res := make([]Optional[Out], len(opts))
+	for i, opt := range opts {
+		if opt.isset {
+			res[i] = Some(fn(i, opt.value))
+		}
+	}
+	return res
+}
+
+// Explode returns an [Optional] holding each element of the slice held by o,
+// in order. If o holds no value, Explode returns an empty slice.
+func Explode[T any](o Optional[[]T]) []Optional[T] {
+	if !o.isset {
+		return []Optional[T]{}
+	}
+	return SomeEach(o.value)
+}
+
+// Implode returns an [Optional] holding the values held by opts, in order,
+// dropping any optionals that hold no value. If none of opts hold a value
+// (including when opts is empty), Implode returns an [Optional] that holds no
+// value. It is the inverse of [Explode].
+func Implode[T any](opts []Optional[T]) Optional[[]T] {
+	var values []T
+	ForEachSome(opts, func(value T) {
+		values = append(values, value)
+	})
+
+	if len(values) == 0 {
+		return None[[]T]()
+	}
+	return Some(values)
+}
+
+// SortedValues returns the values held by opts, sorted in ascending order.
+// Optionals that hold no value are dropped.
+func SortedValues[T cmp.Ordered](opts []Optional[T]) []T {
+	values := make([]T, 0, len(opts))
+	ForEachSome(opts, func(value T) {
+		values = append(values, value)
+	})
+
+	slices.Sort(values)
+	return values
+}
+
+// CollectAllIndexed returns an [Optional] holding the values held by opts, in
+// order, and -1 if every [Optional] in opts holds a value. Otherwise, it
+// returns an [Optional] that holds no value and the index of the first
+// [Optional] that holds no value.
+func CollectAllIndexed[T any](opts []Optional[T]) (Optional[[]T], int) {
+	values := make([]T, len(opts))
+	for i, opt := range opts {
+		if !opt.isset {
+			return None[[]T](), i
+		}
+		values[i] = opt.value
+	}
+	return Some(values), -1
+}
+
+// FirstOccurrences returns an [Optional] for each element of s, holding the
+// element if it is the first occurrence of its value in s, or holding no value
+// if it duplicates an earlier element.
+func FirstOccurrences[T comparable](s []T) []Optional[T] {
+	var (
+		opts = make([]Optional[T], len(s))
+		seen = make(map[T]struct{}, len(s))
+	)
+
+	for i, value := range s {
+		if _, ok := seen[value]; ok {
+			continue
+		}
+
+		seen[value] = struct{}{}
+		opts[i] = Some(value)
+	}
+	return opts
+}
+
+// FlatMapSlice calls fn with each element of s, in order, and returns the
+// values held by the results. Results that hold no value are dropped.
+func FlatMapSlice[In any, Out any](s []In, fn func(In) Optional[Out]) []Out {
+	var res []Out
+	for _, value := range s {
+		if opt := fn(value); opt.isset {
+			res = append(res, opt.value)
+		}
+	}
+	return res
+}
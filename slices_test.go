@@ -0,0 +1,278 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+)
+
+func TestReduceOr(t *testing.T) {
+	var calls int
+	sum := func(a int, b int) int {
+		calls++
+		return a + b
+	}
+
+	res := optional.ReduceOr(nil, sum)
+	require.False(t, res.HasValue())
+
+	res = optional.ReduceOr([]optional.Optional[int]{
+		optional.None[int](),
+		optional.None[int](),
+	}, sum)
+	require.False(t, res.HasValue())
+	require.Equal(t, 0, calls)
+
+	res = optional.ReduceOr([]optional.Optional[int]{
+		optional.None[int](),
+		optional.Some(123),
+		optional.None[int](),
+	}, sum)
+	requireOptionalHasValue(t, 123, res)
+	require.Equal(t, 0, calls)
+
+	res = optional.ReduceOr([]optional.Optional[int]{
+		optional.Some(1),
+		optional.None[int](),
+		optional.Some(2),
+		optional.Some(3),
+		optional.None[int](),
+		optional.Some(4),
+	}, sum)
+	requireOptionalHasValue(t, 10, res)
+	require.Equal(t, 3, calls)
+}
+
+func TestSomeEach(t *testing.T) {
+	require.Empty(t, optional.SomeEach[int](nil))
+
+	opts := optional.SomeEach([]int{1, 0, 3})
+	require.Len(t, opts, 3)
+	requireOptionalHasValue(t, 1, opts[0])
+	requireOptionalHasValue(t, 0, opts[1])
+	requireOptionalHasValue(t, 3, opts[2])
+}
+
+func TestNoneSlice(t *testing.T) {
+	require.Empty(t, optional.NoneSlice[int](0))
+
+	opts := optional.NoneSlice[int](3)
+	require.Len(t, opts, 3)
+	for _, opt := range opts {
+		require.False(t, opt.HasValue())
+	}
+}
+
+func TestForEachSome(t *testing.T) {
+	var have []int
+	fn := func(x int) {
+		have = append(have, x)
+	}
+
+	optional.ForEachSome(nil, fn)
+	require.Empty(t, have)
+
+	optional.ForEachSome([]optional.Optional[int]{
+		optional.None[int](),
+		optional.Some(3),
+		optional.Some(0),
+		optional.None[int](),
+		optional.Some(1),
+	}, fn)
+	require.Equal(t, []int{3, 0, 1}, have)
+}
+
+func TestValuesOr(t *testing.T) {
+	require.Empty(t, optional.ValuesOr(nil, "-"))
+
+	values := optional.ValuesOr([]optional.Optional[string]{
+		optional.Some("a"),
+		optional.None[string](),
+		optional.Some(""),
+		optional.None[string](),
+	}, "-")
+	require.Equal(t, []string{"a", "-", "", "-"}, values)
+}
+
+func TestValuesOrFunc(t *testing.T) {
+	var calls []int
+	fn := func(i int) int {
+		calls = append(calls, i)
+		return -i
+	}
+
+	require.Empty(t, optional.ValuesOrFunc(nil, fn))
+	require.Empty(t, calls)
+
+	values := optional.ValuesOrFunc([]optional.Optional[int]{
+		optional.Some(10),
+		optional.None[int](),
+		optional.Some(20),
+		optional.None[int](),
+		optional.None[int](),
+	}, fn)
+	require.Equal(t, []int{10, -1, 20, -3, -4}, values)
+	require.Equal(t, []int{1, 3, 4}, calls)
+}
+
+func TestMapIndexed(t *testing.T) {
+	var calls []int
+	fn := func(i int, v string) string {
+		calls = append(calls, i)
+		return fmt.Sprintf("%d:%s", i, v)
+	}
+
+	require.Empty(t, optional.MapIndexed(nil, fn))
+
+	res := optional.MapIndexed([]optional.Optional[string]{
+		optional.None[string](),
+		optional.Some("a"),
+		optional.None[string](),
+		optional.Some("b"),
+	}, fn)
+	require.Equal(t, []optional.Optional[string]{
+		optional.None[string](),
+		optional.Some("1:a"),
+		optional.None[string](),
+		optional.Some("3:b"),
+	}, res)
+	require.Equal(t, []int{1, 3}, calls)
+}
+
+func TestExplode(t *testing.T) {
+	res := optional.Explode(optional.None[[]int]())
+	require.NotNil(t, res)
+	require.Empty(t, res)
+
+	res = optional.Explode(optional.Some([]int{}))
+	require.NotNil(t, res)
+	require.Empty(t, res)
+
+	res = optional.Explode(optional.Some([]int{1, 0, 3}))
+	require.Equal(t, []optional.Optional[int]{
+		optional.Some(1),
+		optional.Some(0),
+		optional.Some(3),
+	}, res)
+}
+
+func TestImplode(t *testing.T) {
+	res := optional.Implode[int](nil)
+	require.False(t, res.HasValue())
+
+	res = optional.Implode(optional.NoneSlice[int](3))
+	require.False(t, res.HasValue())
+
+	res = optional.Implode([]optional.Optional[int]{
+		optional.None[int](),
+		optional.Some(1),
+		optional.None[int](),
+		optional.Some(0),
+	})
+	requireOptionalHasValue(t, []int{1, 0}, res)
+
+	values := []int{1, 2, 3}
+	requireOptionalHasValue(
+		t,
+		values,
+		optional.Implode(optional.Explode(optional.Some(values))),
+	)
+}
+
+func TestSortedValues(t *testing.T) {
+	require.Empty(t, optional.SortedValues[int](nil))
+	require.Empty(t, optional.SortedValues(optional.NoneSlice[int](3)))
+
+	values := optional.SortedValues([]optional.Optional[int]{
+		optional.Some(30),
+		optional.None[int](),
+		optional.Some(10),
+		optional.Some(20),
+		optional.None[int](),
+		optional.Some(10),
+	})
+	require.Equal(t, []int{10, 10, 20, 30}, values)
+
+	strs := optional.SortedValues([]optional.Optional[string]{
+		optional.Some("b"),
+		optional.None[string](),
+		optional.Some("a"),
+	})
+	require.Equal(t, []string{"a", "b"}, strs)
+}
+
+func TestCollectAllIndexed(t *testing.T) {
+	res, idx := optional.CollectAllIndexed[int](nil)
+	requireOptionalHasValue(t, []int{}, res)
+	require.Equal(t, -1, idx)
+
+	res, idx = optional.CollectAllIndexed(optional.SomeEach([]int{1, 0, 3}))
+	requireOptionalHasValue(t, []int{1, 0, 3}, res)
+	require.Equal(t, -1, idx)
+
+	res, idx = optional.CollectAllIndexed([]optional.Optional[int]{
+		optional.Some(1),
+		optional.Some(2),
+		optional.None[int](),
+		optional.Some(4),
+		optional.None[int](),
+	})
+	require.False(t, res.HasValue())
+	require.Equal(t, 2, idx)
+
+	res, idx = optional.CollectAllIndexed(optional.NoneSlice[int](2))
+	require.False(t, res.HasValue())
+	require.Equal(t, 0, idx)
+}
+
+func TestFirstOccurrences(t *testing.T) {
+	require.Empty(t, optional.FirstOccurrences[int](nil))
+
+	res := optional.FirstOccurrences([]string{"a", "b", "a", "", "b", "c", ""})
+	require.Equal(t, []optional.Optional[string]{
+		optional.Some("a"),
+		optional.Some("b"),
+		optional.None[string](),
+		optional.Some(""),
+		optional.None[string](),
+		optional.Some("c"),
+		optional.None[string](),
+	}, res)
+}
+
+func TestFlatMapSlice(t *testing.T) {
+	parse := func(s string) optional.Optional[int] {
+		return optional.FromResult(strconv.Atoi(s))
+	}
+
+	require.Empty(t, optional.FlatMapSlice(nil, parse))
+	require.Empty(t, optional.FlatMapSlice([]string{"a", "b"}, parse))
+	require.Equal(
+		t,
+		[]int{3, 1, 0},
+		optional.FlatMapSlice([]string{"3", "x", "1", "", "0"}, parse),
+	)
+}
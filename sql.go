@@ -0,0 +1,43 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional
+
+import (
+	"database/sql"
+)
+
+// ToSQLNull converts the receiver to a [sql.Null], which is valid if and only
+// if a value is held.
+func (o *Optional[T]) ToSQLNull() sql.Null[T] {
+	return sql.Null[T]{
+		V:     o.value,
+		Valid: o.isset,
+	}
+}
+
+// FromSQLNull produces an [Optional] that holds the value of n if n is valid,
+// or that holds no value otherwise.
+func FromSQLNull[T any](n sql.Null[T]) Optional[T] {
+	if !n.Valid {
+		return None[T]()
+	}
+	return Some(n.V)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package optional_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+)
+
+func TestOptional_ToSQLNull(t *testing.T) {
+	var opt optional.Optional[string]
+	require.Equal(t, sql.Null[string]{}, opt.ToSQLNull())
+
+	opt = optional.Some("")
+	require.Equal(t, sql.Null[string]{Valid: true}, opt.ToSQLNull())
+
+	opt = optional.Some("foo")
+	null := opt.ToSQLNull()
+	require.Equal(t, sql.Null[string]{V: "foo", Valid: true}, null)
+	require.Equal(t, opt, optional.FromSQLNull(null))
+
+	now := time.Now()
+	topt := optional.Some(now)
+	tnull := topt.ToSQLNull()
+	require.Equal(t, sql.Null[time.Time]{V: now, Valid: true}, tnull)
+	require.Equal(t, topt, optional.FromSQLNull(tnull))
+}
+
+func TestFromSQLNull(t *testing.T) {
+	opt := optional.FromSQLNull(sql.Null[int]{})
+	require.False(t, opt.HasValue())
+
+	opt = optional.FromSQLNull(sql.Null[int]{V: 123})
+	require.False(t, opt.HasValue())
+
+	requireOptionalHasValue(t, 0, optional.FromSQLNull(sql.Null[int]{Valid: true}))
+	requireOptionalHasValue(
+		t,
+		123,
+		optional.FromSQLNull(sql.Null[int]{V: 123, Valid: true}),
+	)
+
+	none := optional.None[int]()
+	require.Equal(t, none, optional.FromSQLNull(none.ToSQLNull()))
+}
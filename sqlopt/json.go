@@ -0,0 +1,96 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+// Package sqlopt provides database/sql integrations for optional types.
+package sqlopt
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"go.mway.dev/optional"
+)
+
+var (
+	_ sql.Scanner   = (*JSON[any])(nil)
+	_ driver.Valuer = JSON[any]{}
+
+	jsonNull = []byte("null")
+)
+
+// JSON is a nullable JSON column (such as a Postgres JSON or JSONB column)
+// whose value, if present, is decoded into a value of type T.
+type JSON[T any] struct {
+	Optional optional.Optional[T]
+}
+
+// Scan implements [sql.Scanner]. SQL NULL (and a JSON null) scans as an
+// [optional.Optional] that holds no value; JSON bytes or text are unmarshaled
+// into a value of type T.
+func (j *JSON[T]) Scan(src any) error {
+	var data []byte
+	switch x := src.(type) {
+	case nil:
+		j.Optional = optional.None[T]()
+		return nil
+	case []byte:
+		data = x
+	case string:
+		data = []byte(x)
+	default:
+		return fmt.Errorf(
+			"sqlopt: cannot scan %T into JSON[%s]",
+			src,
+			reflect.TypeFor[T](),
+		)
+	}
+
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		j.Optional = optional.None[T]()
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("sqlopt: failed to unmarshal JSON: %w", err)
+	}
+
+	j.Optional = optional.Some(value)
+	return nil
+}
+
+// Value implements [driver.Valuer]. A held value is marshaled to JSON bytes;
+// if no value is held, Value returns nil (SQL NULL).
+func (j JSON[T]) Value() (driver.Value, error) {
+	value, ok := j.Optional.Get()
+	if !ok {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("sqlopt: failed to marshal JSON: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sqlopt_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+	"go.mway.dev/optional/sqlopt"
+)
+
+type testRecord struct {
+	Name  string     `json:"name"`
+	Inner testInner  `json:"inner"`
+	Tags  []string   `json:"tags"`
+	Ptr   *testInner `json:"ptr"`
+}
+
+type testInner struct {
+	Count int `json:"count"`
+}
+
+func TestJSON_Scan(t *testing.T) {
+	want := testRecord{
+		Name:  "foo",
+		Inner: testInner{Count: 123},
+		Tags:  []string{"a", "b"},
+	}
+
+	var col sqlopt.JSON[testRecord]
+	require.NoError(t, col.Scan([]byte(`{"name":"foo","inner":{"count":123},"tags":["a","b"]}`)))
+	have, ok := col.Optional.Get()
+	require.True(t, ok)
+	require.Equal(t, want, have)
+
+	require.NoError(t, col.Scan(nil))
+	require.False(t, col.Optional.HasValue())
+
+	require.NoError(t, col.Scan(`{"name":"bar"}`))
+	have, ok = col.Optional.Get()
+	require.True(t, ok)
+	require.Equal(t, testRecord{Name: "bar"}, have)
+
+	require.NoError(t, col.Scan([]byte("null")))
+	require.False(t, col.Optional.HasValue())
+
+	require.Error(t, col.Scan([]byte(`{`)))
+	require.EqualError(
+		t,
+		col.Scan(123),
+		"sqlopt: cannot scan int into JSON[sqlopt_test.testRecord]",
+	)
+
+	var anyCol sqlopt.JSON[any]
+	require.EqualError(t, anyCol.Scan(123), "sqlopt: cannot scan int into JSON[interface {}]")
+
+	var errCol sqlopt.JSON[error]
+	require.EqualError(t, errCol.Scan(123), "sqlopt: cannot scan int into JSON[error]")
+}
+
+func TestJSON_Value(t *testing.T) {
+	col := sqlopt.JSON[testRecord]{
+		Optional: optional.Some(testRecord{
+			Name:  "foo",
+			Inner: testInner{Count: 123},
+		}),
+	}
+
+	value, err := col.Value()
+	require.NoError(t, err)
+
+	data, ok := value.([]byte)
+	require.True(t, ok)
+	require.JSONEq(
+		t,
+		`{"name":"foo","inner":{"count":123},"tags":null,"ptr":null}`,
+		string(data),
+	)
+
+	var roundtrip sqlopt.JSON[testRecord]
+	require.NoError(t, roundtrip.Scan(value))
+	require.Equal(t, col, roundtrip)
+
+	col = sqlopt.JSON[testRecord]{}
+	value, err = col.Value()
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	bad := sqlopt.JSON[func()]{Optional: optional.Some(func() {})}
+	_, err = bad.Value()
+	require.Error(t, err)
+}
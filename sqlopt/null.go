@@ -0,0 +1,168 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sqlopt
+
+import (
+	"database/sql"
+	"time"
+
+	"go.mway.dev/optional"
+)
+
+// ToNull converts o to a [sql.Null]. If o holds no value, the result is not
+// valid.
+func ToNull[T any](o optional.Optional[T]) sql.Null[T] {
+	return o.ToSQLNull()
+}
+
+// FromNull converts n to an [optional.Optional], which holds no value if n is
+// not valid.
+func FromNull[T any](n sql.Null[T]) optional.Optional[T] {
+	return optional.FromSQLNull(n)
+}
+
+// ToNullString converts o to a [sql.NullString]. If o holds no value, the result
+// is not valid.
+func ToNullString(o optional.Optional[string]) sql.NullString {
+	value, ok := o.Get()
+	return sql.NullString{String: value, Valid: ok}
+}
+
+// FromNullString converts n to an [optional.Optional], which holds no value if n
+// is not valid.
+func FromNullString(n sql.NullString) optional.Optional[string] {
+	if !n.Valid {
+		return optional.None[string]()
+	}
+	return optional.Some(n.String)
+}
+
+// ToNullInt64 converts o to a [sql.NullInt64]. If o holds no value, the result
+// is not valid.
+func ToNullInt64(o optional.Optional[int64]) sql.NullInt64 {
+	value, ok := o.Get()
+	return sql.NullInt64{Int64: value, Valid: ok}
+}
+
+// FromNullInt64 converts n to an [optional.Optional], which holds no value if n
+// is not valid.
+func FromNullInt64(n sql.NullInt64) optional.Optional[int64] {
+	if !n.Valid {
+		return optional.None[int64]()
+	}
+	return optional.Some(n.Int64)
+}
+
+// ToNullInt32 converts o to a [sql.NullInt32]. If o holds no value, the result
+// is not valid.
+func ToNullInt32(o optional.Optional[int32]) sql.NullInt32 {
+	value, ok := o.Get()
+	return sql.NullInt32{Int32: value, Valid: ok}
+}
+
+// FromNullInt32 converts n to an [optional.Optional], which holds no value if n
+// is not valid.
+func FromNullInt32(n sql.NullInt32) optional.Optional[int32] {
+	if !n.Valid {
+		return optional.None[int32]()
+	}
+	return optional.Some(n.Int32)
+}
+
+// ToNullInt16 converts o to a [sql.NullInt16]. If o holds no value, the result
+// is not valid.
+func ToNullInt16(o optional.Optional[int16]) sql.NullInt16 {
+	value, ok := o.Get()
+	return sql.NullInt16{Int16: value, Valid: ok}
+}
+
+// FromNullInt16 converts n to an [optional.Optional], which holds no value if n
+// is not valid.
+func FromNullInt16(n sql.NullInt16) optional.Optional[int16] {
+	if !n.Valid {
+		return optional.None[int16]()
+	}
+	return optional.Some(n.Int16)
+}
+
+// ToNullByte converts o to a [sql.NullByte]. If o holds no value, the result
+// is not valid.
+func ToNullByte(o optional.Optional[byte]) sql.NullByte {
+	value, ok := o.Get()
+	return sql.NullByte{Byte: value, Valid: ok}
+}
+
+// FromNullByte converts n to an [optional.Optional], which holds no value if n
+// is not valid.
+func FromNullByte(n sql.NullByte) optional.Optional[byte] {
+	if !n.Valid {
+		return optional.None[byte]()
+	}
+	return optional.Some(n.Byte)
+}
+
+// ToNullFloat64 converts o to a [sql.NullFloat64]. If o holds no value, the result
+// is not valid.
+func ToNullFloat64(o optional.Optional[float64]) sql.NullFloat64 {
+	value, ok := o.Get()
+	return sql.NullFloat64{Float64: value, Valid: ok}
+}
+
+// FromNullFloat64 converts n to an [optional.Optional], which holds no value if n
+// is not valid.
+func FromNullFloat64(n sql.NullFloat64) optional.Optional[float64] {
+	if !n.Valid {
+		return optional.None[float64]()
+	}
+	return optional.Some(n.Float64)
+}
+
+// ToNullBool converts o to a [sql.NullBool]. If o holds no value, the result
+// is not valid.
+func ToNullBool(o optional.Optional[bool]) sql.NullBool {
+	value, ok := o.Get()
+	return sql.NullBool{Bool: value, Valid: ok}
+}
+
+// FromNullBool converts n to an [optional.Optional], which holds no value if n
+// is not valid.
+func FromNullBool(n sql.NullBool) optional.Optional[bool] {
+	if !n.Valid {
+		return optional.None[bool]()
+	}
+	return optional.Some(n.Bool)
+}
+
+// ToNullTime converts o to a [sql.NullTime]. If o holds no value, the result
+// is not valid.
+func ToNullTime(o optional.Optional[time.Time]) sql.NullTime {
+	value, ok := o.Get()
+	return sql.NullTime{Time: value, Valid: ok}
+}
+
+// FromNullTime converts n to an [optional.Optional], which holds no value if n
+// is not valid.
+func FromNullTime(n sql.NullTime) optional.Optional[time.Time] {
+	if !n.Valid {
+		return optional.None[time.Time]()
+	}
+	return optional.Some(n.Time)
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package sqlopt_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+	"go.mway.dev/optional/sqlopt"
+)
+
+func requireNullRoundTrip[T any, N any](
+	t *testing.T,
+	value T,
+	want N,
+	to func(optional.Optional[T]) N,
+	from func(N) optional.Optional[T],
+) {
+	t.Helper()
+
+	var zero N
+	require.Equal(t, zero, to(optional.None[T]()))
+	none := from(zero)
+	require.False(t, none.HasValue())
+
+	have := to(optional.Some(value))
+	require.Equal(t, want, have)
+
+	opt := from(have)
+	roundtrip, ok := opt.Get()
+	require.True(t, ok)
+	require.Equal(t, value, roundtrip)
+}
+
+func TestToNull(t *testing.T) {
+	requireNullRoundTrip(
+		t,
+		[]string{"a"},
+		sql.Null[[]string]{V: []string{"a"}, Valid: true},
+		sqlopt.ToNull[[]string],
+		sqlopt.FromNull[[]string],
+	)
+}
+
+func TestToNullString(t *testing.T) {
+	requireNullRoundTrip(
+		t,
+		"foo",
+		sql.NullString{String: "foo", Valid: true},
+		sqlopt.ToNullString,
+		sqlopt.FromNullString,
+	)
+}
+
+func TestToNullInt64(t *testing.T) {
+	requireNullRoundTrip(
+		t,
+		int64(123),
+		sql.NullInt64{Int64: int64(123), Valid: true},
+		sqlopt.ToNullInt64,
+		sqlopt.FromNullInt64,
+	)
+}
+
+func TestToNullInt32(t *testing.T) {
+	requireNullRoundTrip(
+		t,
+		int32(123),
+		sql.NullInt32{Int32: int32(123), Valid: true},
+		sqlopt.ToNullInt32,
+		sqlopt.FromNullInt32,
+	)
+}
+
+func TestToNullInt16(t *testing.T) {
+	requireNullRoundTrip(
+		t,
+		int16(123),
+		sql.NullInt16{Int16: int16(123), Valid: true},
+		sqlopt.ToNullInt16,
+		sqlopt.FromNullInt16,
+	)
+}
+
+func TestToNullByte(t *testing.T) {
+	requireNullRoundTrip(
+		t,
+		byte(123),
+		sql.NullByte{Byte: byte(123), Valid: true},
+		sqlopt.ToNullByte,
+		sqlopt.FromNullByte,
+	)
+}
+
+func TestToNullFloat64(t *testing.T) {
+	requireNullRoundTrip(
+		t,
+		1.5,
+		sql.NullFloat64{Float64: 1.5, Valid: true},
+		sqlopt.ToNullFloat64,
+		sqlopt.FromNullFloat64,
+	)
+}
+
+func TestToNullBool(t *testing.T) {
+	requireNullRoundTrip(
+		t,
+		true,
+		sql.NullBool{Bool: true, Valid: true},
+		sqlopt.ToNullBool,
+		sqlopt.FromNullBool,
+	)
+}
+
+func TestToNullTime(t *testing.T) {
+	now := time.Now()
+	requireNullRoundTrip(
+		t,
+		now,
+		sql.NullTime{Time: now, Valid: true},
+		sqlopt.ToNullTime,
+		sqlopt.FromNullTime,
+	)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+// Package tmplfuncs provides template functions for working with optional
+// types in [text/template] and [html/template].
+package tmplfuncs
+
+import (
+	"fmt"
+	"reflect"
+	"text/template"
+
+	"go.mway.dev/optional/internal/optreflect"
+)
+
+// FuncMap returns a [template.FuncMap] (also usable with [html/template])
+// containing the following functions, each of which accepts an
+// [go.mway.dev/optional.Optional] of any type, or a pointer to one:
+//
+//   - isSome: reports whether the optional holds a value
+//   - isNone: reports whether the optional holds no value
+//   - valueOr: returns the held value, or the given default if none is held
+//
+// Each function returns an error if given a value that is not an optional.
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"isSome":  isSome,
+		"isNone":  isNone,
+		"valueOr": valueOr,
+	}
+}
+
+func isSome(opt any) (bool, error) {
+	_, ok, err := get(opt)
+	return ok, err
+}
+
+func isNone(opt any) (bool, error) {
+	_, ok, err := get(opt)
+	return !ok && err == nil, err
+}
+
+func valueOr(opt any, fallback any) (any, error) {
+	value, ok, err := get(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return fallback, nil
+	}
+	return value, nil
+}
+
+func get(opt any) (any, bool, error) {
+	rv := reflect.ValueOf(opt)
+	switch {
+	case !rv.IsValid():
+		return nil, false, fmt.Errorf("tmplfuncs: expected an optional, got nil")
+	case optreflect.IsOptional(rv.Type()):
+	case rv.Kind() == reflect.Pointer && optreflect.IsOptional(rv.Type().Elem()):
+		if rv.IsNil() {
+			return nil, false, nil
+		}
+	default:
+		return nil, false, fmt.Errorf("tmplfuncs: expected an optional, got %T", opt)
+	}
+
+	value, ok := optreflect.Get(rv)
+	return value, ok, nil
+}
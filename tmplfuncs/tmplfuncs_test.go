@@ -0,0 +1,113 @@
+// Copyright (c) 2024 Matt Way
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+// IN THE THE SOFTWARE.
+
+package tmplfuncs_test
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/require"
+	"go.mway.dev/optional"
+	"go.mway.dev/optional/tmplfuncs"
+)
+
+type testData struct {
+	Name  optional.Optional[string]
+	Count *optional.Optional[int]
+}
+
+const testTemplate = `{{ if isSome .Name }}name={{ valueOr .Name "" }}` +
+	`{{ else }}anonymous{{ end }} ` +
+	`{{ if isNone .Count }}count=unknown{{ else }}count={{ valueOr .Count 0 }}{{ end }}`
+
+func TestFuncMap(t *testing.T) {
+	tmpl := template.Must(
+		template.New("test").Funcs(tmplfuncs.FuncMap()).Parse(testTemplate),
+	)
+
+	count := optional.Some(123)
+	cases := []struct {
+		name string
+		data testData
+		want string
+	}{
+		{
+			name: "none",
+			data: testData{},
+			want: "anonymous count=unknown",
+		},
+		{
+			name: "none pointer",
+			data: testData{Count: &optional.Optional[int]{}},
+			want: "anonymous count=unknown",
+		},
+		{
+			name: "some",
+			data: testData{
+				Name:  optional.Some("foo"),
+				Count: &count,
+			},
+			want: "name=foo count=123",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			require.NoError(t, tmpl.Execute(&buf, tt.data))
+			require.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestFuncMap_HTML(t *testing.T) {
+	tmpl := htmltemplate.Must(
+		htmltemplate.New("test").
+			Funcs(tmplfuncs.FuncMap()).
+			Parse(`<b>{{ valueOr . "<none>" }}</b>`),
+	)
+
+	var buf strings.Builder
+	require.NoError(t, tmpl.Execute(&buf, optional.None[string]()))
+	require.Equal(t, "<b>&lt;none&gt;</b>", buf.String())
+
+	buf.Reset()
+	require.NoError(t, tmpl.Execute(&buf, optional.Some("foo")))
+	require.Equal(t, "<b>foo</b>", buf.String())
+}
+
+func TestFuncMap_NotOptional(t *testing.T) {
+	for _, text := range []string{
+		`{{ isSome . }}`,
+		`{{ isNone . }}`,
+		`{{ valueOr . 0 }}`,
+	} {
+		tmpl := template.Must(
+			template.New("test").Funcs(tmplfuncs.FuncMap()).Parse(text),
+		)
+
+		var buf strings.Builder
+		require.Error(t, tmpl.Execute(&buf, 123))
+		require.Error(t, tmpl.Execute(&buf, nil))
+	}
+}